@@ -0,0 +1,208 @@
+package catalog
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/happyhippyhippo/slate-rest/envelope"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+// ICatalog defines the interface of an error catalogue instance.
+type ICatalog interface {
+	Has(id string) bool
+	Entry(id string) (*Entry, error)
+	StatusError(id string, params map[string]interface{}, locale ...string) (*envelope.StatusError, error)
+	Envelope(id string, params map[string]interface{}, locale ...string) (*envelope.Envelope, error)
+	Check() error
+}
+
+// Catalog defines an error catalogue instance loaded from
+// the application configuration.
+type Catalog struct {
+	mutex   sync.RWMutex
+	cfg     config.IManager
+	entries map[string]*Entry
+}
+
+var _ ICatalog = &Catalog{}
+
+// NewCatalog instantiates a new error catalogue loaded with the
+// entries stored in the application configuration. A configuration
+// reload that results in an invalid catalogue, or in a catalogue with
+// duplicate error codes, is logged and discarded, keeping the current
+// entries.
+func NewCatalog(
+	cfg config.IManager,
+	logger log.ILog,
+) (ICatalog, error) {
+	// check the config argument reference
+	if cfg == nil {
+		return nil, errNilPointer("cfg")
+	}
+	// check the logger argument reference
+	if logger == nil {
+		return nil, errNilPointer("logger")
+	}
+	// instantiate and load the catalogue
+	c := &Catalog{
+		cfg:     cfg,
+		entries: map[string]*Entry{},
+	}
+	entries, e := c.load()
+	if e != nil {
+		return nil, e
+	}
+	c.entries = entries
+	// check if is to observe catalogue configuration changes
+	if ObserveConfig {
+		// validate log level
+		logLevel, ok := log.LevelMap[LogLevel]
+		if !ok {
+			logLevel = log.ERROR
+		}
+		// add an observer to the catalogue config that reloads
+		// the entries, keeping the current ones on failure
+		_ = cfg.AddObserver(ConfigPath, func(_ interface{}, _ interface{}) {
+			entries, e := c.load()
+			if e == nil {
+				e = check(entries)
+			}
+			if e != nil {
+				_ = logger.Signal(LogChannel, logLevel, LogReloadErrorMessage, log.Context{"error": e})
+				return
+			}
+			// store the reloaded entries
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+
+			c.entries = entries
+		})
+	}
+	return c, nil
+}
+
+// Has check if the catalogue holds an entry with the given identifier.
+func (c *Catalog) Has(
+	id string,
+) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	_, ok := c.entries[id]
+	return ok
+}
+
+// Entry retrieves the catalogue entry with the given identifier.
+func (c *Catalog) Entry(
+	id string,
+) (*Entry, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	entry, ok := c.entries[id]
+	if !ok {
+		return nil, errEntryNotFound(id)
+	}
+	return entry, nil
+}
+
+// StatusError will compose a new envelope status error from the catalogue
+// entry with the given identifier, composing the message with the given
+// parameters in the first found requested locale.
+func (c *Catalog) StatusError(
+	id string,
+	params map[string]interface{},
+	locale ...string,
+) (*envelope.StatusError, error) {
+	// retrieve the requested entry
+	entry, e := c.Entry(id)
+	if e != nil {
+		return nil, e
+	}
+	// compose the entry message
+	msg, e := entry.Message(params, locale...)
+	if e != nil {
+		return nil, e
+	}
+	return envelope.NewStatusError(entry.Code, msg), nil
+}
+
+// Envelope will compose a new error envelope with the catalogue entry
+// HTTP status and the entry status error.
+func (c *Catalog) Envelope(
+	id string,
+	params map[string]interface{},
+	locale ...string,
+) (*envelope.Envelope, error) {
+	// retrieve the requested entry
+	entry, e := c.Entry(id)
+	if e != nil {
+		return nil, e
+	}
+	// compose the entry message
+	msg, e := entry.Message(params, locale...)
+	if e != nil {
+		return nil, e
+	}
+	se := envelope.NewStatusError(entry.Code, msg)
+	return envelope.NewEnvelope(entry.Status, nil).AddError(se), nil
+}
+
+// Check will validate the catalogue for error codes assigned to more
+// than one entry.
+func (c *Catalog) Check() error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return check(c.entries)
+}
+
+func check(
+	entries map[string]*Entry,
+) error {
+	// group the entries identifiers by error code
+	codes := map[int][]string{}
+	for id, entry := range entries {
+		codes[entry.Code] = append(codes[entry.Code], id)
+	}
+	// search for duplicate codes in a deterministic order
+	var sorted []int
+	for code := range codes {
+		sorted = append(sorted, code)
+	}
+	sort.Ints(sorted)
+	for _, code := range sorted {
+		if ids := codes[code]; len(ids) > 1 {
+			sort.Strings(ids)
+			return errDuplicateCode(code, ids)
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) load() (map[string]*Entry, error) {
+	entries := map[string]*Entry{}
+	// check if there is a catalogue configuration to be loaded
+	if c.cfg.Has(ConfigPath) {
+		// retrieve the catalogue configuration
+		cfg, e := c.cfg.Config(ConfigPath)
+		if e != nil {
+			return nil, e
+		}
+		// parse all the catalogue entries
+		for _, id := range cfg.Entries() {
+			ecfg, e := cfg.Config(id)
+			if e != nil {
+				return nil, errInvalidEntry(id, map[string]interface{}{"error": e})
+			}
+			entry, e := NewEntry(id, ecfg)
+			if e != nil {
+				return nil, e
+			}
+			entries[id] = entry
+		}
+	}
+	return entries, nil
+}
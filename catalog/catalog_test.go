@@ -0,0 +1,393 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+func Test_NewCatalog(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut, e := NewCatalog(nil, NewMockLog(ctrl))
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut, e := NewCatalog(NewMockConfigManager(ctrl), nil)
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("error retrieving the catalogue config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(nil, expected).Times(1)
+
+		sut, e := NewCatalog(cfg, NewMockLog(ctrl))
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, expected):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("invalid catalogue entry config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id": "string"}, nil).Times(1)
+
+		sut, e := NewCatalog(cfg, NewMockLog(ctrl))
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("invalid catalogue entry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id": config.Config{}}, nil).Times(1)
+
+		sut, e := NewCatalog(cfg, NewMockLog(ctrl))
+		switch {
+		case sut != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("empty catalogue if no config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(false).Times(1)
+		cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+
+		if sut, e := NewCatalog(cfg, NewMockLog(ctrl)); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if sut == nil {
+			t.Error("didn't returned a valid reference")
+		} else if sut.Has("id") {
+			t.Error("stored an unexpected entry")
+		}
+	})
+
+	t.Run("load catalogue entries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id": config.Config{"code": 1}}, nil).Times(1)
+		cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+
+		if sut, e := NewCatalog(cfg, NewMockLog(ctrl)); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if !sut.Has("id") {
+			t.Error("didn't stored the expected entry")
+		}
+	})
+
+	t.Run("don't observe config if not requested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := ObserveConfig
+		ObserveConfig = false
+		defer func() { ObserveConfig = prev }()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(false).Times(1)
+
+		if _, e := NewCatalog(cfg, NewMockLog(ctrl)); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		}
+	})
+
+	t.Run("reload the catalogue on config change", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback config.IObserver
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(2)
+		gomock.InOrder(
+			cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id1": config.Config{"code": 1}}, nil),
+			cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id2": config.Config{"code": 2}}, nil),
+		)
+		cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).DoAndReturn(func(_ string, c config.IObserver) error {
+			callback = c
+			return nil
+		}).Times(1)
+
+		sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+		callback(nil, nil)
+
+		if sut.Has("id1") {
+			t.Error("didn't removed the previous entries")
+		} else if !sut.Has("id2") {
+			t.Error("didn't loaded the new entries")
+		}
+	})
+
+	t.Run("keep the catalogue on invalid config reload", func(t *testing.T) {
+		scenarios := []struct {
+			test   string
+			config *config.Config
+			err    error
+		}{
+			{ // invalid catalogue entry
+				test:   "invalid catalogue entry",
+				config: &config.Config{"id2": config.Config{}},
+				err:    ErrInvalidEntry,
+			},
+			{ // duplicate error codes
+				test:   "duplicate error codes",
+				config: &config.Config{"id2": config.Config{"code": 2}, "id3": config.Config{"code": 2}},
+				err:    ErrDuplicateCode,
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				var callback config.IObserver
+				cfg := NewMockConfigManager(ctrl)
+				cfg.EXPECT().Has(ConfigPath).Return(true).Times(2)
+				gomock.InOrder(
+					cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id1": config.Config{"code": 1}}, nil),
+					cfg.EXPECT().Config(ConfigPath).Return(scenario.config, nil),
+				)
+				cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).DoAndReturn(func(_ string, c config.IObserver) error {
+					callback = c
+					return nil
+				}).Times(1)
+				logger := NewMockLog(ctrl)
+				logger.EXPECT().Signal(LogChannel, log.ERROR, LogReloadErrorMessage, gomock.Any()).DoAndReturn(
+					func(_ string, _ log.Level, _ string, ctx ...log.Context) error {
+						if e, ok := ctx[0]["error"].(error); !ok || !errors.Is(e, scenario.err) {
+							t.Errorf("logged the (%v) error when expecting (%v)", ctx[0]["error"], scenario.err)
+						}
+						return nil
+					},
+				).Times(1)
+
+				sut, _ := NewCatalog(cfg, logger)
+				callback(nil, nil)
+
+				if !sut.Has("id1") {
+					t.Error("didn't kept the previous entries")
+				} else if sut.Has("id2") {
+					t.Error("loaded the invalid entries")
+				}
+			})
+		}
+	})
+}
+
+func Test_Catalog_Entry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := NewMockConfigManager(ctrl)
+	cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+	cfg.EXPECT().Config(ConfigPath).Return(&config.Config{"id": config.Config{"code": 1}}, nil).Times(1)
+	cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+	sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+
+	t.Run("entry not found", func(t *testing.T) {
+		if entry, e := sut.Entry("invalid"); entry != nil {
+			t.Error("returned a valid reference")
+		} else if e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrEntryNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrEntryNotFound)
+		}
+	})
+
+	t.Run("retrieve entry", func(t *testing.T) {
+		if entry, e := sut.Entry("id"); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if entry.Code != 1 {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+}
+
+func Test_Catalog_StatusError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := NewMockConfigManager(ctrl)
+	cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+	cfg.EXPECT().Config(ConfigPath).Return(&config.Config{
+		"id":      config.Config{"code": 12, "messages": config.Config{"en": "{{.name}} not found", "pt": "{{.name}} inexistente"}},
+		"invalid": config.Config{"code": 13},
+	}, nil).Times(1)
+	cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+	sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+
+	t.Run("entry not found", func(t *testing.T) {
+		if se, e := sut.StatusError("unknown", nil); se != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrEntryNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrEntryNotFound)
+		}
+	})
+
+	t.Run("message not found", func(t *testing.T) {
+		if se, e := sut.StatusError("invalid", nil); se != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrMessageNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrMessageNotFound)
+		}
+	})
+
+	t.Run("compose status error", func(t *testing.T) {
+		se, e := sut.StatusError("id", map[string]interface{}{"name": "user"}, "pt")
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case se.Code != "c:12":
+			t.Errorf("composed the (%v) code", se.Code)
+		case se.Message != "user inexistente":
+			t.Errorf("composed the (%v) message", se.Message)
+		}
+	})
+}
+
+func Test_Catalog_Envelope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := NewMockConfigManager(ctrl)
+	cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+	cfg.EXPECT().Config(ConfigPath).Return(&config.Config{
+		"id":      config.Config{"code": 12, "status": http.StatusNotFound, "messages": config.Config{"en": "not found"}},
+		"invalid": config.Config{"code": 13},
+	}, nil).Times(1)
+	cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+	sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+
+	t.Run("entry not found", func(t *testing.T) {
+		if env, e := sut.Envelope("unknown", nil); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrEntryNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrEntryNotFound)
+		}
+	})
+
+	t.Run("message not found", func(t *testing.T) {
+		if env, e := sut.Envelope("invalid", nil); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrMessageNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrMessageNotFound)
+		}
+	})
+
+	t.Run("compose envelope", func(t *testing.T) {
+		env, e := sut.Envelope("id", nil)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env.GetStatusCode() != http.StatusNotFound:
+			t.Errorf("composed the (%v) status code", env.GetStatusCode())
+		case len(env.Status.Errors) != 1:
+			t.Errorf("composed the (%v) errors", env.Status.Errors)
+		case env.Status.Errors[0].Message != "not found":
+			t.Errorf("composed the (%v) message", env.Status.Errors[0].Message)
+		}
+	})
+}
+
+func Test_Catalog_Check(t *testing.T) {
+	t.Run("no duplicates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(&config.Config{
+			"id1": config.Config{"code": 1},
+			"id2": config.Config{"code": 2},
+		}, nil).Times(1)
+		cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+		sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+
+		if e := sut.Check(); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		}
+	})
+
+	t.Run("duplicate codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfg.EXPECT().Config(ConfigPath).Return(&config.Config{
+			"id1": config.Config{"code": 1},
+			"id2": config.Config{"code": 2},
+			"id3": config.Config{"code": 1},
+		}, nil).Times(1)
+		cfg.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+		sut, _ := NewCatalog(cfg, NewMockLog(ctrl))
+		expected := "1 : [id1 id3] : duplicate catalogue error code"
+
+		if e := sut.Check(); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrDuplicateCode) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrDuplicateCode)
+		} else if e.Error() != expected {
+			t.Errorf("returned the (%v) error message when expecting (%v)", e, expected)
+		}
+	})
+}
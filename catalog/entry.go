@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/happyhippyhippo/slate/config"
+)
+
+// Entry defines the structure of an error catalogue entry that holds
+// the error code, the HTTP status and the localized message templates
+// associated to an error identifier.
+type Entry struct {
+	ID       string
+	Code     int
+	Status   int
+	Messages map[string]*template.Template
+}
+
+// NewEntry instantiates a new catalogue entry populated with the
+// information stored in the given entry configuration.
+func NewEntry(
+	id string,
+	cfg config.IConfig,
+) (*Entry, error) {
+	// check the config argument reference
+	if cfg == nil {
+		return nil, errNilPointer("cfg")
+	}
+	// retrieve the mandatory entry error code
+	code, e := cfg.Int("code")
+	if e != nil {
+		return nil, errInvalidEntry(id, map[string]interface{}{"error": e})
+	}
+	// retrieve the entry HTTP status
+	status, e := cfg.Int("status", http.StatusInternalServerError)
+	if e != nil {
+		return nil, errInvalidEntry(id, map[string]interface{}{"error": e})
+	}
+	entry := &Entry{
+		ID:       id,
+		Code:     code,
+		Status:   status,
+		Messages: map[string]*template.Template{},
+	}
+	// parse the entry localized message templates
+	if cfg.Has("messages") {
+		messages, e := cfg.Config("messages")
+		if e != nil {
+			return nil, errInvalidEntry(id, map[string]interface{}{"error": e})
+		}
+		for _, locale := range messages.Entries() {
+			msg, e := messages.String(locale)
+			if e != nil {
+				return nil, errInvalidEntry(id, map[string]interface{}{"locale": locale, "error": e})
+			}
+			tmpl, e := template.New(id).Option("missingkey=error").Parse(msg)
+			if e != nil {
+				return nil, errInvalidEntry(id, map[string]interface{}{"locale": locale, "error": e})
+			}
+			entry.Messages[strings.ToLower(locale)] = tmpl
+		}
+	}
+	return entry, nil
+}
+
+// Message will compose the entry message of the first locale found
+// in the given locale list, falling back to the locale base language and
+// to the catalogue default locale.
+func (e *Entry) Message(
+	params map[string]interface{},
+	locale ...string,
+) (string, error) {
+	// search for the message template of the requested locale
+	tmpl := e.template(locale...)
+	if tmpl == nil {
+		return "", errMessageNotFound(e.ID, strings.Join(locale, ","))
+	}
+	// execute the template with the given parameters
+	b := strings.Builder{}
+	if err := tmpl.Execute(&b, params); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (e *Entry) template(
+	locale ...string,
+) *template.Template {
+	// compose the list of locales to be searched
+	var candidates []string
+	for _, l := range locale {
+		l = strings.ToLower(strings.ReplaceAll(l, "_", "-"))
+		candidates = append(candidates, l, strings.Split(l, "-")[0])
+	}
+	candidates = append(candidates, strings.ToLower(Locale))
+	// return the first found template
+	for _, l := range candidates {
+		if tmpl, ok := e.Messages[l]; ok {
+			return tmpl
+		}
+	}
+	return nil
+}
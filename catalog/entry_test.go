@@ -0,0 +1,191 @@
+package catalog
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+)
+
+func Test_NewEntry(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		entry, e := NewEntry("id", nil)
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("missing code", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{})
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{"code": 1, "status": "string"})
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("invalid messages", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{"code": 1, "messages": "string"})
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{"code": 1, "messages": config.Config{"en": 123}})
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("invalid message template", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{"code": 1, "messages": config.Config{"en": "{{.name"}})
+		switch {
+		case entry != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidEntry):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidEntry)
+		}
+	})
+
+	t.Run("default status", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{"code": 1})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case entry.ID != "id":
+			t.Errorf("stored the (%v) id", entry.ID)
+		case entry.Code != 1:
+			t.Errorf("stored the (%v) code", entry.Code)
+		case entry.Status != http.StatusInternalServerError:
+			t.Errorf("stored the (%v) status", entry.Status)
+		case len(entry.Messages) != 0:
+			t.Errorf("stored the (%v) messages", entry.Messages)
+		}
+	})
+
+	t.Run("valid entry", func(t *testing.T) {
+		entry, e := NewEntry("id", &config.Config{
+			"code":     1,
+			"status":   http.StatusNotFound,
+			"messages": config.Config{"EN": "not found", "pt": "inexistente"},
+		})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case entry.Status != http.StatusNotFound:
+			t.Errorf("stored the (%v) status", entry.Status)
+		case len(entry.Messages) != 2:
+			t.Errorf("stored the (%v) messages", entry.Messages)
+		case entry.Messages["en"] == nil:
+			t.Error("didn't stored the lower cased locale message")
+		}
+	})
+}
+
+func Test_Entry_Message(t *testing.T) {
+	entry, _ := NewEntry("id", &config.Config{
+		"code": 1,
+		"messages": config.Config{
+			"en":    "user {{.id}} not found",
+			"pt":    "utilizador {{.id}} inexistente",
+			"pt-br": "usuário {{.id}} inexistente",
+			"fail":  "{{.id.field}}",
+		},
+	})
+	params := map[string]interface{}{"id": 12}
+
+	scenarios := []struct {
+		locale   []string
+		expected string
+	}{
+		{ // default locale
+			locale:   nil,
+			expected: "user 12 not found",
+		},
+		{ // exact locale
+			locale:   []string{"pt"},
+			expected: "utilizador 12 inexistente",
+		},
+		{ // region locale
+			locale:   []string{"pt_BR"},
+			expected: "usuário 12 inexistente",
+		},
+		{ // base language fallback
+			locale:   []string{"pt-PT"},
+			expected: "utilizador 12 inexistente",
+		},
+		{ // first found locale
+			locale:   []string{"fr", "pt"},
+			expected: "utilizador 12 inexistente",
+		},
+		{ // default locale fallback
+			locale:   []string{"fr"},
+			expected: "user 12 not found",
+		},
+	}
+
+	for _, s := range scenarios {
+		if check, e := entry.Message(params, s.locale...); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if check != s.expected {
+			t.Errorf("returned the (%v) message when expecting (%v)", check, s.expected)
+		}
+	}
+
+	t.Run("message not found", func(t *testing.T) {
+		entry, _ := NewEntry("id", &config.Config{"code": 1, "messages": config.Config{"pt": "message"}})
+
+		if _, e := entry.Message(nil, "fr"); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrMessageNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrMessageNotFound)
+		}
+	})
+
+	t.Run("template execution error", func(t *testing.T) {
+		if _, e := entry.Message(params, "fail"); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("missing template parameter", func(t *testing.T) {
+		if check, e := entry.Message(map[string]interface{}{}, "en"); e == nil {
+			t.Errorf("returned the (%v) message when expecting an error", check)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate/env"
+)
+
+const (
+	// EnvID defines the slate.rest.catalog package base environment
+	// variable name.
+	EnvID = rest.EnvID + "_CATALOG"
+)
+
+var (
+	// ConfigPath defines the config path that holds the error
+	// catalogue entries.
+	ConfigPath = env.String(EnvID+"_CONFIG_PATH", "slate.rest.errors")
+
+	// Locale defines the default locale used when retrieving the
+	// catalogue entries messages.
+	Locale = env.String(EnvID+"_LOCALE", "en")
+
+	// ObserveConfig defines the catalogue config observing flag
+	// used to register in the config object an observer of the
+	// catalogue entries, so it can reload the catalogue.
+	ObserveConfig = env.Bool(EnvID+"_OBSERVE_CONFIG", true)
+
+	// LogLevel defines the logging level of the catalogue reload errors.
+	LogLevel = env.String(EnvID+"_LOG_LEVEL", "error")
+
+	// LogChannel defines the logging channel of the catalogue reload
+	// errors.
+	LogChannel = env.String(EnvID+"_LOG_CHANNEL", "rest")
+
+	// LogReloadErrorMessage defines the logging message of a discarded
+	// catalogue configuration reload.
+	LogReloadErrorMessage = env.String(EnvID+"_LOG_RELOAD_ERROR_MESSAGE", "Invalid error catalogue reload")
+)
@@ -0,0 +1,70 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+var (
+	// ErrEntryNotFound defines an error that signal that the requested
+	// error identifier is not present in the catalogue.
+	ErrEntryNotFound = fmt.Errorf("catalogue entry not found")
+
+	// ErrInvalidEntry defines an error that signal that a catalogue
+	// entry configuration was unable to be parsed correctly.
+	ErrInvalidEntry = fmt.Errorf("invalid catalogue entry")
+
+	// ErrDuplicateCode defines an error that signal that the same error
+	// code is assigned to more than one catalogue entry.
+	ErrDuplicateCode = fmt.Errorf("duplicate catalogue error code")
+
+	// ErrMessageNotFound defines an error that signal that a catalogue
+	// entry has no message for the requested locale.
+	ErrMessageNotFound = fmt.Errorf("catalogue message not found")
+)
+
+func errNilPointer(
+	arg string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(slate.ErrNilPointer, arg, ctx...)
+}
+
+func errConversion(
+	val interface{},
+	t string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(slate.ErrConversion, fmt.Sprintf("%v to %s", val, t), ctx...)
+}
+
+func errEntryNotFound(
+	id string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrEntryNotFound, id, ctx...)
+}
+
+func errInvalidEntry(
+	id string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidEntry, id, ctx...)
+}
+
+func errDuplicateCode(
+	code int,
+	ids []string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrDuplicateCode, fmt.Sprintf("%d : %v", code, ids), ctx...)
+}
+
+func errMessageNotFound(
+	id string,
+	locale string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrMessageNotFound, fmt.Sprintf("%s (%s)", id, locale), ctx...)
+}
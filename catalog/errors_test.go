@@ -0,0 +1,183 @@
+package catalog
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_errNilPointer(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : invalid nil pointer"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errNilPointer("dummy argument"); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("error not a instance of slate.ErrNilPointer")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errNilPointer("dummy argument", context); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("error not a instance of slate.ErrNilPointer")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errConversion(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy value to dummy type : invalid type conversion"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errConversion("dummy value", "dummy type"); !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("error not a instance of slate.ErrConversion")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errConversion("dummy value", "dummy type", context); !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("error not a instance of slate.ErrConversion")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errEntryNotFound(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy id : catalogue entry not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errEntryNotFound("dummy id"); !errors.Is(e, ErrEntryNotFound) {
+			t.Errorf("error not a instance of ErrEntryNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errEntryNotFound("dummy id", context); !errors.Is(e, ErrEntryNotFound) {
+			t.Errorf("error not a instance of ErrEntryNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errInvalidEntry(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy id : invalid catalogue entry"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidEntry("dummy id"); !errors.Is(e, ErrInvalidEntry) {
+			t.Errorf("error not a instance of ErrInvalidEntry")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidEntry("dummy id", context); !errors.Is(e, ErrInvalidEntry) {
+			t.Errorf("error not a instance of ErrInvalidEntry")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errDuplicateCode(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "123 : [id1 id2] : duplicate catalogue error code"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errDuplicateCode(123, []string{"id1", "id2"}); !errors.Is(e, ErrDuplicateCode) {
+			t.Errorf("error not a instance of ErrDuplicateCode")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errDuplicateCode(123, []string{"id1", "id2"}, context); !errors.Is(e, ErrDuplicateCode) {
+			t.Errorf("error not a instance of ErrDuplicateCode")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errMessageNotFound(t *testing.T) {
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy id (en) : catalogue message not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errMessageNotFound("dummy id", "en"); !errors.Is(e, ErrMessageNotFound) {
+			t.Errorf("error not a instance of ErrMessageNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errMessageNotFound("dummy id", "en", context); !errors.Is(e, ErrMessageNotFound) {
+			t.Errorf("error not a instance of ErrMessageNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
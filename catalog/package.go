@@ -0,0 +1,5 @@
+// Package catalog implements a configuration driven error catalogue
+// that maps error identifiers into unique error codes, HTTP statuses and
+// localized messages, so that normalized envelope errors can be generated
+// without hand-written codes and messages in every endpoint.
+package catalog
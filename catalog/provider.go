@@ -0,0 +1,65 @@
+package catalog
+
+import (
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest"
+)
+
+const (
+	// ID defines the id to be used as the container
+	// registration id of the error catalogue instance.
+	ID = rest.ID + ".catalog"
+)
+
+// Provider defines the slate.rest.catalog module service provider to be
+// used on the application initialization to register the error catalogue.
+type Provider struct{}
+
+var _ slate.IProvider = &Provider{}
+
+// Register will register the error catalogue instance in the
+// application container.
+func (p Provider) Register(
+	container ...slate.IContainer,
+) error {
+	// check container argument reference
+	if len(container) == 0 || container[0] == nil {
+		return errNilPointer("container")
+	}
+	// add the error catalogue
+	_ = container[0].Service(ID, NewCatalog)
+	return nil
+}
+
+// Boot will validate the error catalogue for duplicate error codes.
+func (p Provider) Boot(
+	container ...slate.IContainer,
+) error {
+	// check container argument reference
+	if len(container) == 0 || container[0] == nil {
+		return errNilPointer("container")
+	}
+	// retrieve the error catalogue
+	catalog, e := p.getCatalog(container[0])
+	if e != nil {
+		return e
+	}
+	// check the catalogue for duplicate codes
+	return catalog.Check()
+}
+
+func (Provider) getCatalog(
+	container slate.IContainer,
+) (ICatalog, error) {
+	// retrieve the catalogue entry
+	entry, e := container.Get(ID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(ICatalog)
+	if !ok {
+		return nil, errConversion(entry, "catalog.ICatalog")
+	}
+	return instance, nil
+}
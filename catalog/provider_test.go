@@ -0,0 +1,177 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+func Test_Provider_Register(t *testing.T) {
+	t.Run("no argument", func(t *testing.T) {
+		if e := (&Provider{}).Register(); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expected (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil container", func(t *testing.T) {
+		if e := (&Provider{}).Register(nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expected (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("register components", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		e := sut.Register(container)
+		switch {
+		case e != nil:
+			t.Errorf("returned the (%v) error", e)
+		case !container.Has(ID):
+			t.Errorf("didn't registered the catalogue : %v", sut)
+		}
+	})
+
+	t.Run("error retrieving config manager when retrieving the catalogue", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(config.ID, func() (config.IManager, error) { return nil, expected })
+
+		if _, e := container.Get(ID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("retrieving catalogue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		cfgManager := NewMockConfigManager(ctrl)
+		cfgManager.EXPECT().Has(ConfigPath).Return(false).Times(1)
+		cfgManager.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+		_ = container.Service(config.ID, func() (config.IManager, error) { return cfgManager, nil })
+		_ = container.Service(log.ID, func() (log.ILog, error) { return NewMockLog(ctrl), nil })
+
+		sut, e := container.Get(ID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case sut == nil:
+			t.Error("didn't returned a reference to the catalogue")
+		default:
+			switch sut.(type) {
+			case ICatalog:
+			default:
+				t.Error("didn't returned a catalogue reference")
+			}
+		}
+	})
+}
+
+func Test_Provider_Boot(t *testing.T) {
+	t.Run("no argument", func(t *testing.T) {
+		if e := (&Provider{}).Boot(); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expected (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil container", func(t *testing.T) {
+		if e := (&Provider{}).Boot(nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expected (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("error retrieving catalogue", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(ID, func() (ICatalog, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid catalogue", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(ID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("duplicate catalogue codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		cfgManager.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfgManager.EXPECT().Config(ConfigPath).Return(&config.Config{
+			"id1": config.Config{"code": 1},
+			"id2": config.Config{"code": 1},
+		}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(config.ID, func() (config.IManager, error) { return cfgManager, nil })
+		_ = container.Service(log.ID, func() (log.ILog, error) { return NewMockLog(ctrl), nil })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrDuplicateCode) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrDuplicateCode)
+		}
+	})
+
+	t.Run("successful boot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		cfgManager.EXPECT().Has(ConfigPath).Return(true).Times(1)
+		cfgManager.EXPECT().Config(ConfigPath).Return(&config.Config{
+			"id1": config.Config{"code": 1},
+			"id2": config.Config{"code": 2},
+		}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver(ConfigPath, gomock.Any()).Return(nil).Times(1)
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(config.ID, func() (config.IManager, error) { return cfgManager, nil })
+		_ = container.Service(log.ID, func() (log.ILog, error) { return NewMockLog(ctrl), nil })
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		}
+	})
+}
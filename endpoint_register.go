@@ -1,7 +1,10 @@
 package rest
 
 // IEndpointRegister defines an interface to an instance that
-// is able to register endpoints to the REST engine/service
+// is able to register endpoints to the REST engine/service.
+// Registers that name their routes, so hypermedia links can be built
+// to them, should depend on the IRouteRegistry service and register
+// the routes through it.
 type IEndpointRegister interface {
 	Reg(engine Engine) error
 }
@@ -35,4 +35,8 @@ var (
 
 	// LogEndMessage defines the default service end logging message.
 	LogEndMessage = env.String(EnvID+"_LOG_END_MESSAGE", "[service:rest] service terminated")
+
+	// LogRouteNotFoundMessage defines the default logging message of a
+	// named route not registered in the engine.
+	LogRouteNotFoundMessage = env.String(EnvID+"_LOG_ROUTE_NOT_FOUND_MESSAGE", "[service:rest] named route not registered")
 )
@@ -0,0 +1,117 @@
+package envelope
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// BatchItem defines the structure of a batch response item that holds
+// the item own response status code, status and data.
+type BatchItem struct {
+	ID         string      `json:"id,omitempty" xml:"id,attr,omitempty"`
+	StatusCode int         `json:"statusCode" xml:"statusCode"`
+	Status     *Status     `json:"status" xml:"status"`
+	Data       interface{} `json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// NewBatchItem instantiates a new batch response item.
+func NewBatchItem(
+	id string,
+	statusCode int,
+	data interface{},
+) *BatchItem {
+	return &BatchItem{
+		ID:         id,
+		StatusCode: statusCode,
+		Status:     NewStatus(),
+		Data:       data,
+	}
+}
+
+// AddError append a new error to the item status error list
+func (i *BatchItem) AddError(
+	e *StatusError,
+) *BatchItem {
+	if i.Status == nil {
+		i.Status = NewStatus()
+	}
+	i.Status = i.Status.AddError(e)
+	return i
+}
+
+// BatchItemList defines a type of data that holds a list
+// of batch response items.
+type BatchItemList []*BatchItem
+
+// Success check if all the list items were successful.
+func (l BatchItemList) Success() bool {
+	for _, item := range l {
+		if (item.Status != nil && !item.Status.Success) || item.StatusCode >= http.StatusBadRequest {
+			return false
+		}
+	}
+	return true
+}
+
+// StatusCode retrieves the aggregated status code of the list items. If
+// all the items share the same status code, then that code is returned,
+// otherwise the multi-status code is returned.
+func (l BatchItemList) StatusCode() int {
+	if len(l) == 0 {
+		return http.StatusOK
+	}
+	code := l[0].StatusCode
+	for _, item := range l[1:] {
+		if item.StatusCode != code {
+			return http.StatusMultiStatus
+		}
+	}
+	return code
+}
+
+// MarshalXML serialize the item list into a xml string
+func (l BatchItemList) MarshalXML(
+	e *xml.Encoder,
+	start xml.StartElement,
+) error {
+	// encode the list starting tag
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	// encode all the list items
+	for _, item := range l {
+		if err := e.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: "item"}}); err != nil {
+			return err
+		}
+	}
+	// encode the terminating list tag
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML deserialize the item list from a xml string
+func (l *BatchItemList) UnmarshalXML(
+	d *xml.Decoder,
+	start xml.StartElement,
+) error {
+	list := BatchItemList{}
+	for {
+		// retrieve the next list token
+		token, e := d.Token()
+		if e != nil {
+			return e
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			// decode the iterated list item
+			item := &BatchItem{}
+			if e := d.DecodeElement(item, &t); e != nil {
+				return e
+			}
+			list = append(list, item)
+		case xml.EndElement:
+			// store the list when reaching the terminating list tag
+			*l = list
+			return nil
+		}
+	}
+}
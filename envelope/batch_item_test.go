@@ -0,0 +1,181 @@
+package envelope
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Test_NewBatchItem(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		item := NewBatchItem("id", http.StatusCreated, "data")
+		switch {
+		case item.ID != "id":
+			t.Errorf("stored the (%v) id", item.ID)
+		case item.StatusCode != http.StatusCreated:
+			t.Errorf("stored the (%v) status code", item.StatusCode)
+		case item.Status == nil || !item.Status.Success:
+			t.Errorf("stored the (%v) status", item.Status)
+		case item.Data != "data":
+			t.Errorf("stored the (%v) data", item.Data)
+		}
+	})
+}
+
+func Test_BatchItem_AddError(t *testing.T) {
+	t.Run("add error", func(t *testing.T) {
+		e := NewStatusError(1, "message")
+		item := NewBatchItem("id", http.StatusConflict, nil).AddError(e)
+
+		if item.Status.Success {
+			t.Error("didn't flagged the item status as unsuccessful")
+		} else if len(item.Status.Errors) != 1 || item.Status.Errors[0] != e {
+			t.Errorf("stored the (%v) errors", item.Status.Errors)
+		}
+	})
+	t.Run("add error to an item without status", func(t *testing.T) {
+		e := NewStatusError(1, "message")
+		item := (&BatchItem{ID: "id", StatusCode: http.StatusConflict}).AddError(e)
+
+		if item.Status == nil || item.Status.Success {
+			t.Errorf("stored the (%v) status", item.Status)
+		} else if len(item.Status.Errors) != 1 || item.Status.Errors[0] != e {
+			t.Errorf("stored the (%v) errors", item.Status.Errors)
+		}
+	})
+}
+
+func Test_BatchItemList_Success(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		list     BatchItemList
+		expected bool
+	}{
+		{
+			name:     "empty list",
+			list:     BatchItemList{},
+			expected: true,
+		},
+		{
+			name:     "successful items",
+			list:     BatchItemList{NewBatchItem("1", http.StatusOK, nil), NewBatchItem("2", http.StatusCreated, nil)},
+			expected: true,
+		},
+		{
+			name:     "item with error status code",
+			list:     BatchItemList{NewBatchItem("1", http.StatusOK, nil), NewBatchItem("2", http.StatusNotFound, nil)},
+			expected: false,
+		},
+		{
+			name:     "item with errors",
+			list:     BatchItemList{NewBatchItem("1", http.StatusOK, nil).AddError(NewStatusError(1, "message"))},
+			expected: false,
+		},
+		{
+			name:     "item without status",
+			list:     BatchItemList{&BatchItem{ID: "1", StatusCode: http.StatusOK}},
+			expected: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if check := s.list.Success(); check != s.expected {
+				t.Errorf("returned (%v) when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_BatchItemList_StatusCode(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		list     BatchItemList
+		expected int
+	}{
+		{
+			name:     "empty list",
+			list:     BatchItemList{},
+			expected: http.StatusOK,
+		},
+		{
+			name:     "same status code",
+			list:     BatchItemList{NewBatchItem("1", http.StatusCreated, nil), NewBatchItem("2", http.StatusCreated, nil)},
+			expected: http.StatusCreated,
+		},
+		{
+			name:     "mixed status codes",
+			list:     BatchItemList{NewBatchItem("1", http.StatusCreated, nil), NewBatchItem("2", http.StatusNotFound, nil)},
+			expected: http.StatusMultiStatus,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if check := s.list.StatusCode(); check != s.expected {
+				t.Errorf("returned (%v) when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_BatchItemList_MarshalXML(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		buffer := strings.Builder{}
+		start := xml.StartElement{Name: xml.Name{Local: "items"}}
+		expected := "<items></items>"
+
+		encoder := xml.NewEncoder(&buffer)
+
+		if e := (BatchItemList{}).MarshalXML(encoder, start); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if _ = encoder.Flush(); buffer.String() != expected {
+			t.Errorf("marshaled the list into (%v) when expecting (%v)", buffer.String(), expected)
+		}
+	})
+
+	t.Run("item list", func(t *testing.T) {
+		list := BatchItemList{NewBatchItem("1", http.StatusOK, "data")}
+		data, e := xml.Marshal(struct {
+			XMLName xml.Name      `xml:"start"`
+			Items   BatchItemList `xml:"items"`
+		}{Items: list})
+		expected := `<start><items><item id="1"><statusCode>200</statusCode><status><success>true</success><error></error></status><data>data</data></item></items></start>`
+
+		if e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if string(data) != expected {
+			t.Errorf("marshaled the list into (%v) when expecting (%v)", string(data), expected)
+		}
+	})
+}
+
+func Test_BatchItemList_UnmarshalXML(t *testing.T) {
+	t.Run("invalid xml", func(t *testing.T) {
+		list := BatchItemList{}
+
+		if e := xml.Unmarshal([]byte("<items><item>"), &list); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("invalid item", func(t *testing.T) {
+		list := BatchItemList{}
+
+		if e := xml.Unmarshal([]byte("<items><item><statusCode>string</statusCode></item></items>"), &list); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("item list", func(t *testing.T) {
+		list := BatchItemList{}
+		data := `<items><item id="1"><statusCode>200</statusCode><status><success>true</success><error></error></status></item></items>`
+
+		if e := xml.Unmarshal([]byte(data), &list); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if len(list) != 1 || list[0].ID != "1" || list[0].StatusCode != http.StatusOK {
+			t.Errorf("unmarshaled the (%v) list", list)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package envelope
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// CursorCodec defines an instance used to encode and decode the opaque
+// and signed cursors used in keyset pagination.
+type CursorCodec struct {
+	key []byte
+}
+
+// NewCursorCodec instantiates a new cursor codec that will sign the
+// generated cursors with the given key.
+func NewCursorCodec(
+	key []byte,
+) (*CursorCodec, error) {
+	// check the key argument reference
+	if len(key) == 0 {
+		return nil, errNilPointer("key")
+	}
+	// return the codec instance
+	return &CursorCodec{
+		key: key,
+	}, nil
+}
+
+// Encode will serialize the given keyset value into an opaque cursor
+// string with an appended signature.
+func (c CursorCodec) Encode(
+	value interface{},
+) (string, error) {
+	// serialize the value to be stored in the cursor
+	payload, e := json.Marshal(value)
+	if e != nil {
+		return "", e
+	}
+	// compose the cursor with the encoded payload and its signature
+	return c.encode(payload) + "." + c.encode(c.sign(payload)), nil
+}
+
+// Decode will validate the signature of the given cursor and populate
+// the target with the keyset value stored in it.
+func (c CursorCodec) Decode(
+	cursor string,
+	target interface{},
+) error {
+	// check the target argument reference
+	if target == nil {
+		return errNilPointer("target")
+	}
+	// split the cursor into the payload and signature sections
+	sections := strings.Split(cursor, ".")
+	if len(sections) != 2 {
+		return errInvalidCursor(cursor)
+	}
+	payload, e := base64.RawURLEncoding.DecodeString(sections[0])
+	if e != nil {
+		return errInvalidCursor(cursor, map[string]interface{}{"error": e})
+	}
+	signature, e := base64.RawURLEncoding.DecodeString(sections[1])
+	if e != nil {
+		return errInvalidCursor(cursor, map[string]interface{}{"error": e})
+	}
+	// validate the payload signature
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return errInvalidCursor(cursor)
+	}
+	// deserialize the payload into the target
+	if e := json.Unmarshal(payload, target); e != nil {
+		return errInvalidCursor(cursor, map[string]interface{}{"error": e})
+	}
+	return nil
+}
+
+func (c CursorCodec) sign(
+	payload []byte,
+) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (CursorCodec) encode(
+	b []byte,
+) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,129 @@
+package envelope
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewCursorCodec(t *testing.T) {
+	t.Run("nil key", func(t *testing.T) {
+		if codec, e := NewCursorCodec(nil); codec != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		if codec, e := NewCursorCodec([]byte{}); codec != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("construct", func(t *testing.T) {
+		if codec, e := NewCursorCodec([]byte("key")); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if codec == nil {
+			t.Error("didn't returned the expected codec reference")
+		}
+	})
+}
+
+func Test_CursorCodec_Encode(t *testing.T) {
+	t.Run("error serializing the value", func(t *testing.T) {
+		codec, _ := NewCursorCodec([]byte("key"))
+
+		if cursor, e := codec.Encode(func() {}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if cursor != "" {
+			t.Errorf("returned the unexpected (%v) cursor", cursor)
+		}
+	})
+
+	t.Run("encode the value", func(t *testing.T) {
+		codec, _ := NewCursorCodec([]byte("key"))
+
+		cursor, e := codec.Encode(map[string]interface{}{"id": 12})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case len(strings.Split(cursor, ".")) != 2:
+			t.Errorf("returned the (%v) cursor without a signature section", cursor)
+		case strings.Contains(cursor, "id"):
+			t.Errorf("returned the (%v) non-opaque cursor", cursor)
+		}
+	})
+}
+
+func Test_CursorCodec_Decode(t *testing.T) {
+	t.Run("nil target", func(t *testing.T) {
+		codec, _ := NewCursorCodec([]byte("key"))
+		cursor, _ := codec.Encode(12)
+
+		if e := codec.Decode(cursor, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("invalid cursor format", func(t *testing.T) {
+		scenarios := []string{
+			"",
+			"payload",
+			"pay.load.signature",
+			"!!!.signature",
+			"cGF5bG9hZA.!!!",
+		}
+
+		codec, _ := NewCursorCodec([]byte("key"))
+		for _, scenario := range scenarios {
+			target := 0
+			if e := codec.Decode(scenario, &target); !errors.Is(e, ErrInvalidCursor) {
+				t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidCursor)
+			}
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		codec1, _ := NewCursorCodec([]byte("key 1"))
+		codec2, _ := NewCursorCodec([]byte("key 2"))
+		cursor, _ := codec1.Encode(12)
+
+		target := 0
+		if e := codec2.Decode(cursor, &target); !errors.Is(e, ErrInvalidCursor) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidCursor)
+		}
+	})
+
+	t.Run("error deserializing into the target", func(t *testing.T) {
+		codec, _ := NewCursorCodec([]byte("key"))
+		cursor, _ := codec.Encode("string")
+
+		target := 0
+		if e := codec.Decode(cursor, &target); !errors.Is(e, ErrInvalidCursor) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidCursor)
+		}
+	})
+
+	t.Run("decode the cursor", func(t *testing.T) {
+		type keyset struct {
+			ID   int
+			Name string
+		}
+		value := keyset{ID: 12, Name: "name"}
+		codec, _ := NewCursorCodec([]byte("key"))
+		cursor, _ := codec.Encode(value)
+
+		target := keyset{}
+		if e := codec.Decode(cursor, &target); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if !reflect.DeepEqual(target, value) {
+			t.Errorf("decoded the (%v) value when expecting (%v)", target, value)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package envelope
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// CursorQueryParam defines the name of the query parameter used
+	// to transport the pagination cursor.
+	CursorQueryParam = "cursor"
+
+	// CountQueryParam defines the name of the query parameter used
+	// to transport the requested page size.
+	CountQueryParam = "count"
+
+	// StartQueryParam defines the name of the query parameter used
+	// by the offset pagination to transport the page starting record.
+	StartQueryParam = "start"
+
+	// SearchQueryParam defines the name of the query parameter used
+	// by the offset pagination to transport the search term.
+	SearchQueryParam = "search"
+)
+
+// CursorReport defines the structure of a response keyset report
+// containing the opaque cursors of the previous and next pages, the
+// optional total of records and the links used to retrieve those pages
+type CursorReport struct {
+	Count      uint   `json:"count" xml:"count"`
+	Total      *uint  `json:"total,omitempty" xml:"total,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty" xml:"prevCursor,omitempty"`
+	NextCursor string `json:"nextCursor,omitempty" xml:"nextCursor,omitempty"`
+	Prev       string `json:"prev" xml:"prev"`
+	Next       string `json:"next" xml:"next"`
+}
+
+// NewCursorReport instantiates a new response cursor report by
+// populating the prev and next link information from the given cursors
+// while preserving all the other current request query parameters
+func NewCursorReport(
+	query url.Values,
+	count uint,
+	prev,
+	next string,
+) *CursorReport {
+	// return the cursor report instance reference
+	return &CursorReport{
+		Count:      count,
+		Total:      nil,
+		PrevCursor: prev,
+		NextCursor: next,
+		Prev:       cursorLink(query, count, prev),
+		Next:       cursorLink(query, count, next),
+	}
+}
+
+// SetTotal assign the total number of filtered records to the report
+func (r *CursorReport) SetTotal(
+	total uint,
+) *CursorReport {
+	r.Total = &total
+	return r
+}
+
+// Resolve will convert the stored relative links into absolute
+// links by resolving them against the given base URL
+func (r *CursorReport) Resolve(
+	base *url.URL,
+) *CursorReport {
+	r.Prev = resolveLink(base, r.Prev)
+	r.Next = resolveLink(base, r.Next)
+	return r
+}
+
+func cursorLink(
+	query url.Values,
+	count uint,
+	cursor string,
+) string {
+	// no link is generated if there is no cursor to point to
+	if cursor == "" {
+		return ""
+	}
+	// copy the current request query parameters
+	values := url.Values{}
+	for k, v := range query {
+		values[k] = append([]string{}, v...)
+	}
+	// override the pagination parameters, removing any offset
+	// pagination parameter that can collide with the cursor
+	values.Del(StartQueryParam)
+	values.Set(CountQueryParam, fmt.Sprintf("%d", count))
+	values.Set(CursorQueryParam, cursor)
+	// compose the URL page query parameters
+	return "?" + values.Encode()
+}
@@ -0,0 +1,114 @@
+package envelope
+
+import (
+	"net/url"
+	"testing"
+)
+
+func Test_NewCursorReport(t *testing.T) {
+	t.Run("store the cursor parameters", func(t *testing.T) {
+		scenarios := []struct {
+			query url.Values
+			count uint
+			prev  string
+			next  string
+			plink string
+			nlink string
+		}{
+			{ // report without cursors
+				query: url.Values{},
+				count: uint(2),
+				prev:  "",
+				next:  "",
+				plink: "",
+				nlink: "",
+			},
+			{ // report on first page
+				query: url.Values{},
+				count: uint(2),
+				prev:  "",
+				next:  "next",
+				plink: "",
+				nlink: "?count=2&cursor=next",
+			},
+			{ // report on last page
+				query: url.Values{},
+				count: uint(2),
+				prev:  "prev",
+				next:  "",
+				plink: "?count=2&cursor=prev",
+				nlink: "",
+			},
+			{ // report preserving the request query parameters
+				query: url.Values{"search": {"search string"}, "tag": {"a", "b"}},
+				count: uint(2),
+				prev:  "prev",
+				next:  "next",
+				plink: "?count=2&cursor=prev&search=search+string&tag=a&tag=b",
+				nlink: "?count=2&cursor=next&search=search+string&tag=a&tag=b",
+			},
+			{ // report overriding the request pagination parameters
+				query: url.Values{"start": {"10"}, "count": {"5"}, "cursor": {"current"}},
+				count: uint(2),
+				prev:  "prev",
+				next:  "next",
+				plink: "?count=2&cursor=prev",
+				nlink: "?count=2&cursor=next",
+			},
+		}
+
+		for _, scenario := range scenarios {
+			report := NewCursorReport(scenario.query, scenario.count, scenario.prev, scenario.next)
+
+			if check := report.Count; check != scenario.count {
+				t.Errorf("stored the (%v) listing record count when expecting (%v)", check, scenario.count)
+			} else if report.Total != nil {
+				t.Errorf("stored the unexpected (%v) listing record total", *report.Total)
+			} else if check := report.PrevCursor; check != scenario.prev {
+				t.Errorf("stored the (%v) prev cursor when expecting (%v)", check, scenario.prev)
+			} else if check := report.NextCursor; check != scenario.next {
+				t.Errorf("stored the (%v) next cursor when expecting (%v)", check, scenario.next)
+			} else if check := report.Prev; check != scenario.plink {
+				t.Errorf("stored the (%v) prev link when expecting (%v)", check, scenario.plink)
+			} else if check := report.Next; check != scenario.nlink {
+				t.Errorf("stored the (%v) next link when expecting (%v)", check, scenario.nlink)
+			}
+		}
+	})
+
+	t.Run("don't change the given query parameters", func(t *testing.T) {
+		query := url.Values{"start": {"10"}}
+		_ = NewCursorReport(query, 2, "prev", "next")
+
+		if check := query.Encode(); check != "start=10" {
+			t.Errorf("changed the query parameters to (%v)", check)
+		}
+	})
+}
+
+func Test_CursorReport_SetTotal(t *testing.T) {
+	t.Run("assign the total", func(t *testing.T) {
+		report := NewCursorReport(url.Values{}, 2, "", "").SetTotal(10)
+
+		if report.Total == nil {
+			t.Error("didn't stored the total")
+		} else if check := *report.Total; check != 10 {
+			t.Errorf("stored the (%v) total when expecting (10)", check)
+		}
+	})
+}
+
+func Test_CursorReport_Resolve(t *testing.T) {
+	t.Run("resolve the links", func(t *testing.T) {
+		base, _ := url.Parse("https://domain.com/resource")
+		report := NewCursorReport(url.Values{}, 2, "", "next").Resolve(base)
+
+		if check := report.Prev; check != "" {
+			t.Errorf("stored the (%v) prev link", check)
+		} else if check := report.Next; check != "https://domain.com/resource?count=2&cursor=next" {
+			t.Errorf("stored the (%v) next link", check)
+		} else if check := report.NextCursor; check != "next" {
+			t.Errorf("stored the (%v) next cursor", check)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package envelope
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Decode will parse the given JSON or XML encoded envelope, selected by
+// the given mime type, into a typed envelope.
+func Decode[T any](
+	data []byte,
+	mimeType string,
+) (*TypedEnvelope[T], error) {
+	// discover the envelope encoding format
+	mediaType, _, e := mime.ParseMediaType(mimeType)
+	if e != nil {
+		return nil, errUnsupportedFormat(mimeType, map[string]interface{}{"error": e})
+	}
+	// decode the envelope with the discovered format
+	env := &TypedEnvelope[T]{}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		e = json.Unmarshal(data, env)
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		e = xml.Unmarshal(data, env)
+	default:
+		return nil, errUnsupportedFormat(mimeType)
+	}
+	if e != nil {
+		return nil, e
+	}
+	return env, nil
+}
+
+// DecodeResponse will parse the given HTTP response body into a typed
+// envelope, selecting the decoding format from the response content
+// type header and storing the response status code in the envelope.
+func DecodeResponse[T any](
+	res *http.Response,
+) (*TypedEnvelope[T], error) {
+	// check the response argument reference
+	if res == nil || res.Body == nil {
+		return nil, errNilPointer("res")
+	}
+	// read the response body
+	data, e := io.ReadAll(res.Body)
+	if e != nil {
+		return nil, e
+	}
+	// decode the response envelope
+	env, e := Decode[T](data, res.Header.Get("Content-Type"))
+	if e != nil {
+		return nil, e
+	}
+	env.StatusCode = res.StatusCode
+	return env, nil
+}
@@ -0,0 +1,176 @@
+package envelope
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+type decoderTestData struct {
+	ID   int    `json:"id" xml:"id"`
+	Name string `json:"name" xml:"name"`
+}
+
+type decoderTestReader struct{}
+
+func (decoderTestReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("error message")
+}
+
+func Test_Decode(t *testing.T) {
+	t.Run("invalid mime type", func(t *testing.T) {
+		if env, e := Decode[decoderTestData]([]byte("{}"), ""); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrUnsupportedFormat) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrUnsupportedFormat)
+		}
+	})
+
+	t.Run("unsupported mime type", func(t *testing.T) {
+		if env, e := Decode[decoderTestData]([]byte("{}"), "text/plain"); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrUnsupportedFormat) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrUnsupportedFormat)
+		}
+	})
+
+	t.Run("invalid content", func(t *testing.T) {
+		if env, e := Decode[decoderTestData]([]byte("{"), "application/json"); env != nil {
+			t.Error("returned a valid reference")
+		} else if e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	scenarios := []struct {
+		name   string
+		mime   string
+		encode func(interface{}) ([]byte, error)
+	}{
+		{name: "json", mime: "application/json; charset=utf-8", encode: json.Marshal},
+		{name: "xml", mime: "application/xml; charset=utf-8", encode: xml.Marshal},
+	}
+
+	for _, s := range scenarios {
+		t.Run("decode successful "+s.name+" envelope", func(t *testing.T) {
+			data := decoderTestData{ID: 12, Name: "name"}
+			report := NewListReport("search", 0, 10, 20)
+			encoded, _ := s.encode(Page([]decoderTestData{data}, report))
+
+			env, e := Decode[[]decoderTestData](encoded, s.mime)
+			switch {
+			case e != nil:
+				t.Errorf("returned the unexpected (%v) error", e)
+			case !env.Success():
+				t.Error("didn't decoded a successful envelope")
+			case !reflect.DeepEqual(env.Data, []decoderTestData{data}):
+				t.Errorf("decoded the (%v) data", env.Data)
+			case !reflect.DeepEqual(env.ListReport, report):
+				t.Errorf("decoded the (%v) list report", env.ListReport)
+			}
+		})
+
+		t.Run("decode error "+s.name+" envelope", func(t *testing.T) {
+			encoded, _ := s.encode(NewEnvelope(http.StatusNotFound, nil).
+				AddError(NewStatusError(1, "message 1").SetField("name").SetMeta("min", "3")).
+				AddError(NewStatusError(2, "message 2")).
+				SetService(3))
+
+			env, e := Decode[*decoderTestData](encoded, s.mime)
+			switch {
+			case e != nil:
+				t.Errorf("returned the unexpected (%v) error", e)
+			case env.Success():
+				t.Error("decoded a successful envelope")
+			case env.Data != nil:
+				t.Errorf("decoded the (%v) data", env.Data)
+			case len(env.Errors()) != 2:
+				t.Errorf("decoded the (%v) errors", env.Errors())
+			case env.Errors()[0].Code != "s:3.c:1" || env.Errors()[0].Message != "message 1":
+				t.Errorf("decoded the (%v) first error", env.Errors()[0])
+			case env.Errors()[0].Field != "name" || env.Errors()[0].Meta["min"] != "3":
+				t.Errorf("decoded the (%v) first error metadata", env.Errors()[0])
+			case env.Errors()[1].Code != "s:3.c:2" || env.Errors()[1].Message != "message 2":
+				t.Errorf("decoded the (%v) second error", env.Errors()[1])
+			}
+		})
+
+		t.Run("decode batch "+s.name+" envelope", func(t *testing.T) {
+			encoded, _ := s.encode(NewBatchEnvelope(
+				NewBatchItem("1", http.StatusCreated, "data"),
+				NewBatchItem("2", http.StatusNotFound, nil).AddError(NewStatusError(1, "not found")),
+			))
+
+			env, e := Decode[*decoderTestData](encoded, s.mime)
+			switch {
+			case e != nil:
+				t.Errorf("returned the unexpected (%v) error", e)
+			case len(env.Items) != 2:
+				t.Errorf("decoded the (%v) items", env.Items)
+			case env.Items[0].ID != "1" || env.Items[0].StatusCode != http.StatusCreated:
+				t.Errorf("decoded the (%v) first item", env.Items[0])
+			case env.Items[1].Status.Success || env.Items[1].Status.Errors[0].Message != "not found":
+				t.Errorf("decoded the (%v) second item status", env.Items[1].Status)
+			}
+		})
+	}
+}
+
+func Test_DecodeResponse(t *testing.T) {
+	t.Run("nil response", func(t *testing.T) {
+		if env, e := DecodeResponse[int](nil); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("error reading body", func(t *testing.T) {
+		res := &http.Response{Body: io.NopCloser(decoderTestReader{})}
+
+		if env, e := DecodeResponse[int](res); env != nil {
+			t.Error("returned a valid reference")
+		} else if e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		res := &http.Response{
+			Header: http.Header{"Content-Type": {"text/plain"}},
+			Body:   io.NopCloser(strings.NewReader("{}")),
+		}
+
+		if env, e := DecodeResponse[int](res); env != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrUnsupportedFormat) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrUnsupportedFormat)
+		}
+	})
+
+	t.Run("decode response", func(t *testing.T) {
+		res := &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"status":{"success":true,"error":[]},"data":{"id":1,"name":"name"}}`)),
+		}
+
+		env, e := DecodeResponse[decoderTestData](res)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env.StatusCode != http.StatusCreated:
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		case env.Data != decoderTestData{ID: 1, Name: "name"}:
+			t.Errorf("decoded the (%v) data", env.Data)
+		}
+	})
+}
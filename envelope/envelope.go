@@ -2,15 +2,19 @@ package envelope
 
 import (
 	"encoding/xml"
+	"net/http"
 )
 
 // Envelope identifies the structure of a response structured format.
 type Envelope struct {
-	XMLName    xml.Name    `json:"-" xml:"envelope"`
-	StatusCode int         `json:"-" xml:"-"`
-	Status     *Status     `json:"status" xml:"status"`
-	ListReport *ListReport `json:"report,omitempty" xml:"report,omitempty"`
-	Data       interface{} `json:"data,omitempty" xml:"data,omitempty"`
+	XMLName      xml.Name      `json:"-" xml:"envelope"`
+	StatusCode   int           `json:"-" xml:"-"`
+	Status       *Status       `json:"status" xml:"status"`
+	ListReport   *ListReport   `json:"report,omitempty" xml:"report,omitempty"`
+	CursorReport *CursorReport `json:"cursor,omitempty" xml:"cursor,omitempty"`
+	Links        LinkList      `json:"_links,omitempty" xml:"link,omitempty"`
+	Items        BatchItemList `json:"items,omitempty" xml:"items,omitempty"`
+	Data         interface{}   `json:"data,omitempty" xml:"data,omitempty"`
 }
 
 // NewEnvelope instantiates a new response data envelope structure
@@ -21,10 +25,11 @@ func NewEnvelope(
 ) *Envelope {
 	// initialize the envelope structure
 	env := &Envelope{
-		StatusCode: statusCode,
-		Status:     NewStatus(),
-		ListReport: nil,
-		Data:       data,
+		StatusCode:   statusCode,
+		Status:       NewStatus(),
+		ListReport:   nil,
+		CursorReport: nil,
+		Data:         data,
 	}
 	// assign the list report if given as argument
 	if len(listReport) > 0 && listReport[0] != nil {
@@ -43,6 +48,11 @@ func (s *Envelope) SetService(
 	val int,
 ) *Envelope {
 	s.Status = s.Status.SetService(val)
+	for _, item := range s.Items {
+		if item.Status != nil {
+			item.Status = item.Status.SetService(val)
+		}
+	}
 	return s
 }
 
@@ -51,6 +61,11 @@ func (s *Envelope) SetEndpoint(
 	val int,
 ) *Envelope {
 	s.Status = s.Status.SetEndpoint(val)
+	for _, item := range s.Items {
+		if item.Status != nil {
+			item.Status = item.Status.SetEndpoint(val)
+		}
+	}
 	return s
 }
 
@@ -62,6 +77,29 @@ func (s *Envelope) SetListReport(
 	return s
 }
 
+// SetCursorReport assign the keyset pagination report to the envelope
+func (s *Envelope) SetCursorReport(
+	cursorReport *CursorReport,
+) *Envelope {
+	s.CursorReport = cursorReport
+	return s
+}
+
+// AddLink add a new hypermedia link with the given relation to the
+// response envelope instance
+func (s *Envelope) AddLink(
+	rel string,
+	link *Link,
+) *Envelope {
+	// check the link argument reference
+	if link == nil {
+		return s
+	}
+	link.Rel = rel
+	s.Links = append(s.Links, link)
+	return s
+}
+
 // AddError add a new error to the response envelope instance
 func (s *Envelope) AddError(
 	e *StatusError,
@@ -69,3 +107,44 @@ func (s *Envelope) AddError(
 	s.Status = s.Status.AddError(e)
 	return s
 }
+
+// AddItem add a new batch item to the response envelope instance and
+// update the envelope status code and success flag with the aggregated
+// items status codes and outcomes
+func (s *Envelope) AddItem(
+	item *BatchItem,
+) *Envelope {
+	// check the item argument reference
+	if item == nil {
+		return s
+	}
+	// assign a default status to an item without one
+	if item.Status == nil {
+		item.Status = NewStatus()
+	}
+	if s.Status == nil {
+		s.Status = NewStatus()
+	}
+	s.Items = append(s.Items, item)
+	s.StatusCode = s.Items.StatusCode()
+	// the envelope is only successful if all the items are
+	s.Status.Success = len(s.Status.Errors) == 0 && s.Items.Success()
+	return s
+}
+
+// IsBatch check if the envelope is a batch response envelope
+func (s Envelope) IsBatch() bool {
+	return len(s.Items) != 0
+}
+
+// NewBatchEnvelope instantiates a new batch response envelope
+// with the given items
+func NewBatchEnvelope(
+	items ...*BatchItem,
+) *Envelope {
+	env := NewEnvelope(http.StatusOK, nil)
+	for _, item := range items {
+		env.AddItem(item)
+	}
+	return env
+}
@@ -1,6 +1,9 @@
 package envelope
 
 import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
 	"reflect"
 	"testing"
 )
@@ -185,3 +188,236 @@ func Test_Envelope_SetListReport(t *testing.T) {
 		}
 	})
 }
+
+func Test_Envelope_SetCursorReport(t *testing.T) {
+	t.Run("assign the cursor report", func(t *testing.T) {
+		statusCode := 123
+		data := "message"
+		report := NewCursorReport(nil, 2, "prev", "next")
+		env := NewEnvelope(statusCode, data)
+		env.SetCursorReport(report)
+
+		if check := env.Data; !reflect.DeepEqual(check, data) {
+			t.Errorf("stored the (%v) value in data field instead of expected (%v)", check, data)
+		} else if check := env.CursorReport; !reflect.DeepEqual(check, report) {
+			t.Errorf("stored the (%v) value in cursor field instead of expected (%v)", check, report)
+		} else if env.ListReport != nil {
+			t.Errorf("stored the unexpected (%v) list report", env.ListReport)
+		}
+	})
+
+	t.Run("remove the cursor report if nil given", func(t *testing.T) {
+		env := NewEnvelope(123, "message").SetCursorReport(NewCursorReport(nil, 2, "prev", "next"))
+		env.SetCursorReport(nil)
+
+		if env.CursorReport != nil {
+			t.Errorf("stored the unexpected (%v) cursor report", env.CursorReport)
+		}
+	})
+}
+
+func Test_Envelope_AddItem(t *testing.T) {
+	t.Run("ignore nil item", func(t *testing.T) {
+		env := NewEnvelope(http.StatusOK, nil).AddItem(nil)
+
+		if len(env.Items) != 0 {
+			t.Errorf("stored the (%v) items", env.Items)
+		}
+	})
+
+	t.Run("add items and aggregate status code", func(t *testing.T) {
+		item1 := NewBatchItem("1", http.StatusCreated, nil)
+		item2 := NewBatchItem("2", http.StatusConflict, nil)
+		env := NewEnvelope(http.StatusOK, nil).AddItem(item1)
+
+		if check := env.GetStatusCode(); check != http.StatusCreated {
+			t.Errorf("aggregated the (%v) status code", check)
+		} else if env.AddItem(item2); !reflect.DeepEqual(env.Items, BatchItemList{item1, item2}) {
+			t.Errorf("stored the (%v) items", env.Items)
+		} else if check := env.GetStatusCode(); check != http.StatusMultiStatus {
+			t.Errorf("aggregated the (%v) status code", check)
+		}
+	})
+
+	t.Run("aggregate the items outcome", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			items    []*BatchItem
+			expected bool
+		}{
+			{ // all items succeeded
+				test:     "all items succeeded",
+				items:    []*BatchItem{NewBatchItem("1", http.StatusCreated, nil), NewBatchItem("2", http.StatusOK, nil)},
+				expected: true,
+			},
+			{ // some items failed
+				test:     "some items failed",
+				items:    []*BatchItem{NewBatchItem("1", http.StatusCreated, nil), NewBatchItem("2", http.StatusConflict, nil)},
+				expected: false,
+			},
+			{ // all items failed
+				test: "all items failed",
+				items: []*BatchItem{
+					NewBatchItem("1", http.StatusUnprocessableEntity, nil).AddError(NewStatusError(1, "invalid")),
+					NewBatchItem("2", http.StatusUnprocessableEntity, nil).AddError(NewStatusError(2, "invalid")),
+				},
+				expected: false,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				env := NewEnvelope(http.StatusOK, nil)
+				for _, item := range s.items {
+					env.AddItem(item)
+				}
+
+				if check := env.Status.Success; check != s.expected {
+					t.Errorf("flagged the envelope success as (%v)", check)
+				}
+			})
+		}
+	})
+
+	t.Run("keep the envelope errors outcome", func(t *testing.T) {
+		env := NewEnvelope(http.StatusOK, nil).
+			AddError(NewStatusError(1, "error")).
+			AddItem(NewBatchItem("1", http.StatusOK, nil))
+
+		if env.Status.Success {
+			t.Error("flagged the envelope as successful")
+		}
+	})
+
+	t.Run("assign a default status to an item without status", func(t *testing.T) {
+		item := &BatchItem{ID: "1", StatusCode: http.StatusOK}
+		env := NewEnvelope(http.StatusOK, nil).AddItem(item).SetService(1).SetEndpoint(2)
+
+		if item.Status == nil || !item.Status.Success {
+			t.Errorf("assigned the (%v) item status", item.Status)
+		} else if !env.Status.Success {
+			t.Error("flagged the envelope as unsuccessful")
+		}
+	})
+}
+
+func Test_Envelope_IsBatch(t *testing.T) {
+	t.Run("non batch envelope", func(t *testing.T) {
+		if NewEnvelope(http.StatusOK, nil).IsBatch() {
+			t.Error("flagged a non batch envelope")
+		}
+	})
+
+	t.Run("batch envelope", func(t *testing.T) {
+		if !NewBatchEnvelope(NewBatchItem("1", http.StatusOK, nil)).IsBatch() {
+			t.Error("didn't flagged a batch envelope")
+		}
+	})
+}
+
+func Test_NewBatchEnvelope(t *testing.T) {
+	t.Run("empty batch", func(t *testing.T) {
+		env := NewBatchEnvelope()
+
+		if check := env.GetStatusCode(); check != http.StatusOK {
+			t.Errorf("stored the (%v) status code", check)
+		} else if len(env.Items) != 0 {
+			t.Errorf("stored the (%v) items", env.Items)
+		}
+	})
+
+	t.Run("all items failed", func(t *testing.T) {
+		env := NewBatchEnvelope(
+			NewBatchItem("1", http.StatusUnprocessableEntity, nil).AddError(NewStatusError(1, "invalid")),
+			NewBatchItem("2", http.StatusUnprocessableEntity, nil).AddError(NewStatusError(2, "invalid")),
+		)
+
+		if check := env.GetStatusCode(); check != http.StatusUnprocessableEntity {
+			t.Errorf("stored the (%v) status code", check)
+		} else if env.Status.Success {
+			t.Error("flagged the envelope as successful")
+		}
+	})
+
+	t.Run("ignore the items without status when assigning the service and endpoint", func(t *testing.T) {
+		env := NewBatchEnvelope(NewBatchItem("1", http.StatusOK, nil))
+		env.Items = append(env.Items, &BatchItem{ID: "2", StatusCode: http.StatusOK})
+
+		if env.SetService(1).SetEndpoint(2); env.Items[1].Status != nil {
+			t.Errorf("assigned the (%v) item status", env.Items[1].Status)
+		}
+	})
+
+	t.Run("assign service and endpoint to the items errors", func(t *testing.T) {
+		env := NewBatchEnvelope(
+			NewBatchItem("1", http.StatusCreated, "data"),
+			NewBatchItem("2", http.StatusNotFound, nil).AddError(NewStatusError(3, "not found")),
+		).SetService(1).SetEndpoint(2)
+
+		if check := env.Items[1].Status.Errors[0].Code; check != "s:1.e:2.c:3" {
+			t.Errorf("composed the (%v) item error code", check)
+		}
+	})
+
+	t.Run("serialize", func(t *testing.T) {
+		env := NewBatchEnvelope(
+			NewBatchItem("1", http.StatusCreated, "data"),
+			NewBatchItem("2", http.StatusNotFound, nil).AddError(NewStatusError(3, "not found")),
+		)
+		expectedJSON := `{"status":{"success":false,"error":[]},"items":[{"id":"1","statusCode":201,"status":{"success":true,"error":[]},"data":"data"},{"id":"2","statusCode":404,"status":{"success":false,"error":[{"code":"c:3","message":"not found"}]}}]}`
+		expectedXML := `<envelope><status><success>false</success><error></error></status><items><item id="1"><statusCode>201</statusCode><status><success>true</success><error></error></status><data>data</data></item><item id="2"><statusCode>404</statusCode><status><success>false</success><error><error code="c:3" message="not found"></error></error></status></item></items></envelope>`
+
+		if check, e := json.Marshal(env); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expectedJSON {
+			t.Errorf("serialized (%v) when expecting (%v)", string(check), expectedJSON)
+		} else if check, e := xml.Marshal(env); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expectedXML {
+			t.Errorf("serialized (%v) when expecting (%v)", string(check), expectedXML)
+		}
+	})
+}
+
+func Test_Envelope_AddLink(t *testing.T) {
+	t.Run("ignore nil link", func(t *testing.T) {
+		env := NewEnvelope(http.StatusOK, nil).AddLink(LinkSelf, nil)
+
+		if len(env.Links) != 0 {
+			t.Errorf("stored the (%v) links", env.Links)
+		}
+	})
+
+	t.Run("add links with relation", func(t *testing.T) {
+		self := NewLink("/users/1")
+		edit := NewLink("/users/1").SetMethod("put")
+		env := NewEnvelope(http.StatusOK, nil).AddLink(LinkSelf, self).AddLink("edit", edit)
+
+		switch {
+		case !reflect.DeepEqual(env.Links, LinkList{self, edit}):
+			t.Errorf("stored the (%v) links", env.Links)
+		case self.Rel != LinkSelf:
+			t.Errorf("assigned the (%v) relation", self.Rel)
+		case edit.Rel != "edit":
+			t.Errorf("assigned the (%v) relation", edit.Rel)
+		}
+	})
+
+	t.Run("serialize", func(t *testing.T) {
+		env := NewEnvelope(http.StatusOK, nil).
+			AddLink(LinkSelf, NewLink("/users/1")).
+			AddLink("edit", NewLink("/users/1").SetMethod(http.MethodPut))
+		expectedJSON := `{"status":{"success":true,"error":[]},"_links":{"self":{"href":"/users/1"},"edit":{"href":"/users/1","method":"PUT"}}}`
+		expectedXML := `<envelope><status><success>true</success><error></error></status><link rel="self" href="/users/1"></link><link rel="edit" href="/users/1" method="PUT"></link></envelope>`
+
+		if check, e := json.Marshal(env); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expectedJSON {
+			t.Errorf("serialized (%v) when expecting (%v)", string(check), expectedJSON)
+		} else if check, e := xml.Marshal(env); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expectedXML {
+			t.Errorf("serialized (%v) when expecting (%v)", string(check), expectedXML)
+		}
+	})
+}
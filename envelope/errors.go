@@ -0,0 +1,38 @@
+package envelope
+
+import (
+	"fmt"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+var (
+	// ErrInvalidCursor defines an error that signal that a given
+	// pagination cursor could not be decoded or failed the signature check.
+	ErrInvalidCursor = fmt.Errorf("invalid pagination cursor")
+
+	// ErrUnsupportedFormat defines an error that signal that an envelope
+	// can't be decoded from the given content format.
+	ErrUnsupportedFormat = fmt.Errorf("unsupported envelope format")
+)
+
+func errNilPointer(
+	arg string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(slate.ErrNilPointer, arg, ctx...)
+}
+
+func errInvalidCursor(
+	cursor string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidCursor, cursor, ctx...)
+}
+
+func errUnsupportedFormat(
+	format string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrUnsupportedFormat, format, ctx...)
+}
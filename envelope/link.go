@@ -0,0 +1,172 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"net/url"
+	"strings"
+)
+
+const (
+	// LinkSelf defines the relation name of the link to the
+	// enveloped resource.
+	LinkSelf = "self"
+)
+
+// Link defines the structure of a hypermedia link to a related
+// resource or action.
+type Link struct {
+	XMLName   xml.Name `json:"-" xml:"link"`
+	Rel       string   `json:"-" xml:"rel,attr"`
+	Href      string   `json:"href" xml:"href,attr"`
+	Method    string   `json:"method,omitempty" xml:"method,attr,omitempty"`
+	Templated bool     `json:"templated,omitempty" xml:"templated,attr,omitempty"`
+	Title     string   `json:"title,omitempty" xml:"title,attr,omitempty"`
+}
+
+// NewLink instantiates a new hypermedia link to the given location.
+func NewLink(
+	href string,
+) *Link {
+	return &Link{
+		Href: href,
+	}
+}
+
+// SetMethod assign the HTTP method to be used when following the link.
+func (l *Link) SetMethod(
+	method string,
+) *Link {
+	l.Method = strings.ToUpper(method)
+	return l
+}
+
+// SetTemplated assign the flag that signals that the link location
+// is an URI template.
+func (l *Link) SetTemplated(
+	templated bool,
+) *Link {
+	l.Templated = templated
+	return l
+}
+
+// SetTitle assign the human-readable title of the link.
+func (l *Link) SetTitle(
+	title string,
+) *Link {
+	l.Title = title
+	return l
+}
+
+// Resolve will convert the link relative location into an absolute
+// location by resolving it against the given base URL
+func (l *Link) Resolve(
+	base *url.URL,
+) *Link {
+	// templated locations can't be parsed as URLs without escaping the
+	// template expressions, so only absolute paths are prefixed
+	if l.Templated {
+		if base != nil && strings.HasPrefix(l.Href, "/") && !strings.HasPrefix(l.Href, "//") {
+			l.Href = (&url.URL{Scheme: base.Scheme, Host: base.Host}).String() + l.Href
+		}
+		return l
+	}
+	l.Href = resolveLink(base, l.Href)
+	return l
+}
+
+// LinkList defines a list of hypermedia links that is encoded in JSON
+// as an HAL links object grouped by relation.
+type LinkList []*Link
+
+// Get retrieves all the stored links with the given relation.
+func (l LinkList) Get(
+	rel string,
+) []*Link {
+	var links []*Link
+	for _, link := range l {
+		if link.Rel == rel {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// MarshalJSON encodes the link list as an HAL links object. The
+// relations with a single link are encoded as a link object, and the
+// relations with multiple links are encoded as an array of link objects.
+func (l LinkList) MarshalJSON() ([]byte, error) {
+	// group the links by relation keeping the insertion order
+	var rels []string
+	groups := map[string][]*Link{}
+	for _, link := range l {
+		if _, ok := groups[link.Rel]; !ok {
+			rels = append(rels, link.Rel)
+		}
+		groups[link.Rel] = append(groups[link.Rel], link)
+	}
+	// encode the grouped relations
+	buf := bytes.NewBufferString("{")
+	for i, rel := range rels {
+		if i != 0 {
+			buf.WriteString(",")
+		}
+		key, _ := json.Marshal(rel)
+		buf.Write(key)
+		buf.WriteString(":")
+		var value interface{} = groups[rel]
+		if len(groups[rel]) == 1 {
+			value = groups[rel][0]
+		}
+		data, e := json.Marshal(value)
+		if e != nil {
+			return nil, e
+		}
+		buf.Write(data)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes an HAL links object into the link list.
+func (l *LinkList) UnmarshalJSON(
+	data []byte,
+) error {
+	// decode the links object keeping the relations order
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, e := decoder.Token(); e != nil {
+		return e
+	}
+	list := LinkList{}
+	for decoder.More() {
+		token, e := decoder.Token()
+		if e != nil {
+			return e
+		}
+		rel, _ := token.(string)
+		var raw json.RawMessage
+		if e := decoder.Decode(&raw); e != nil {
+			return e
+		}
+		// decode the relation as a single link or a list of links
+		var links []*Link
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) != 0 && trimmed[0] == '[' {
+			if e := json.Unmarshal(raw, &links); e != nil {
+				return e
+			}
+		} else {
+			link := &Link{}
+			if e := json.Unmarshal(raw, link); e != nil {
+				return e
+			}
+			links = append(links, link)
+		}
+		for _, link := range links {
+			link.Rel = rel
+			list = append(list, link)
+		}
+	}
+	*l = list
+	return nil
+}
@@ -0,0 +1,157 @@
+package envelope
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func Test_NewLink(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		href := "/users/1"
+
+		if check := NewLink(href); check == nil {
+			t.Error("didn't returned a valid reference")
+		} else if check.Href != href {
+			t.Errorf("stored the (%v) location", check.Href)
+		}
+	})
+}
+
+func Test_Link_Setters(t *testing.T) {
+	t.Run("assign the link information", func(t *testing.T) {
+		link := NewLink("/users/{id}").SetMethod("delete").SetTemplated(true).SetTitle("remove")
+
+		switch {
+		case link.Method != http.MethodDelete:
+			t.Errorf("stored the (%v) method", link.Method)
+		case !link.Templated:
+			t.Error("didn't flagged the link as templated")
+		case link.Title != "remove":
+			t.Errorf("stored the (%v) title", link.Title)
+		}
+	})
+}
+
+func Test_Link_Resolve(t *testing.T) {
+	base, _ := url.Parse("https://domain.com/users?count=2")
+
+	t.Run("resolve relative location", func(t *testing.T) {
+		if check := NewLink("/users/1").Resolve(base).Href; check != "https://domain.com/users/1" {
+			t.Errorf("resolved the (%v) location", check)
+		}
+	})
+
+	t.Run("resolve templated location without escaping the expressions", func(t *testing.T) {
+		if check := NewLink("/users/{id}").SetTemplated(true).Resolve(base).Href; check != "https://domain.com/users/{id}" {
+			t.Errorf("resolved the (%v) location", check)
+		}
+	})
+
+	t.Run("don't resolve relative templated location", func(t *testing.T) {
+		if check := NewLink("{id}").SetTemplated(true).Resolve(base).Href; check != "{id}" {
+			t.Errorf("resolved the (%v) location", check)
+		}
+	})
+
+	t.Run("no-op on nil base", func(t *testing.T) {
+		if check := NewLink("/users/1").Resolve(nil).Href; check != "/users/1" {
+			t.Errorf("resolved the (%v) location", check)
+		}
+	})
+}
+
+func Test_LinkList_Get(t *testing.T) {
+	t.Run("retrieve the relation links", func(t *testing.T) {
+		first := &Link{Rel: "item", Href: "/users/1"}
+		second := &Link{Rel: "item", Href: "/users/2"}
+		list := LinkList{first, {Rel: LinkSelf, Href: "/users"}, second}
+
+		if check := list.Get("item"); !reflect.DeepEqual(check, []*Link{first, second}) {
+			t.Errorf("retrieved the (%v) links", check)
+		} else if check := list.Get("other"); check != nil {
+			t.Errorf("retrieved the (%v) links", check)
+		}
+	})
+}
+
+func Test_LinkList_MarshalJSON(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		if check, e := json.Marshal(LinkList{}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != `{}` {
+			t.Errorf("serialized (%v)", string(check))
+		}
+	})
+
+	t.Run("group links by relation", func(t *testing.T) {
+		list := LinkList{
+			{Rel: LinkSelf, Href: "/users"},
+			{Rel: "item", Href: "/users/1"},
+			{Rel: "find", Href: "/users/{id}", Method: http.MethodGet, Templated: true},
+			{Rel: "item", Href: "/users/2"},
+		}
+		expected := `{"self":{"href":"/users"},"item":[{"href":"/users/1"},{"href":"/users/2"}],"find":{"href":"/users/{id}","method":"GET","templated":true}}`
+
+		if check, e := json.Marshal(list); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expected {
+			t.Errorf("serialized (%v) when expecting (%v)", string(check), expected)
+		}
+	})
+}
+
+func Test_LinkList_UnmarshalJSON(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		list := LinkList{}
+		if e := list.UnmarshalJSON([]byte(`{"self":`)); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("invalid link", func(t *testing.T) {
+		list := LinkList{}
+		if e := list.UnmarshalJSON([]byte(`{"self":"string"}`)); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("invalid link list", func(t *testing.T) {
+		list := LinkList{}
+		if e := list.UnmarshalJSON([]byte(`{"self":["string"]}`)); e == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("decode the HAL links object", func(t *testing.T) {
+		data := `{"self":{"href":"/users"},"item":[{"href":"/users/1"},{"href":"/users/2"}],"find":{"href":"/users/{id}","method":"GET","templated":true}}`
+		expected := LinkList{
+			{Rel: LinkSelf, Href: "/users"},
+			{Rel: "item", Href: "/users/1"},
+			{Rel: "item", Href: "/users/2"},
+			{Rel: "find", Href: "/users/{id}", Method: http.MethodGet, Templated: true},
+		}
+
+		list := LinkList{}
+		if e := json.Unmarshal([]byte(data), &list); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if !reflect.DeepEqual(list, expected) {
+			t.Errorf("decoded (%v) when expecting (%v)", list, expected)
+		}
+	})
+
+	t.Run("xml round trip", func(t *testing.T) {
+		env := NewEnvelope(http.StatusOK, nil).AddLink(LinkSelf, NewLink("/users/{id}").SetTemplated(true))
+		data, _ := xml.Marshal(env)
+
+		check := &Envelope{}
+		if e := xml.Unmarshal(data, check); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if len(check.Links) != 1 || check.Links[0].Rel != LinkSelf || !check.Links[0].Templated {
+			t.Errorf("decoded the (%v) links", check.Links)
+		}
+	})
+}
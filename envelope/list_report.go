@@ -2,18 +2,22 @@ package envelope
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // ListReport defines the structure of a response list report
 // containing all the request information, but also the total amount of
-// filtering records and links for the previous and next pages
+// filtering records and links for the previous and next pages. The first
+// and last page links are only presented if the page links are set
 type ListReport struct {
 	Search string `json:"search" xml:"search"`
 	Start  uint   `json:"start" xml:"start"`
 	Count  uint   `json:"count" xml:"count"`
 	Total  uint   `json:"total" xml:"total"`
+	First  string `json:"first,omitempty" xml:"first,omitempty"`
 	Prev   string `json:"prev" xml:"prev"`
 	Next   string `json:"next" xml:"next"`
+	Last   string `json:"last,omitempty" xml:"last,omitempty"`
 }
 
 // NewListReport instantiates a new response list report by
@@ -25,33 +29,91 @@ func NewListReport(
 	count,
 	total uint,
 ) *ListReport {
+	r := &ListReport{
+		Search: search,
+		Start:  start,
+		Count:  count,
+		Total:  total,
+	}
+	r.Prev, r.Next = r.pages(func(search string, start, count uint) string {
+		return fmt.Sprintf("?search=%s&start=%d&count=%d", search, start, count)
+	})
+	return r
+}
+
+// SetPageLinks will populate the first and last page links, and
+// compose the prev and next page links with the search terms query
+// escaped, so all the links are valid URL references
+func (r *ListReport) SetPageLinks() *ListReport {
+	r.Prev, r.Next = r.pages(listLink)
+	// discover the last page starting value
+	lstart := uint(0)
+	if r.Count > 0 && r.Total > 0 {
+		lstart = ((r.Total - 1) / r.Count) * r.Count
+	}
+	r.First = listLink(r.Search, 0, r.Count)
+	r.Last = listLink(r.Search, lstart, r.Count)
+	return r
+}
+
+// Resolve will convert all the stored relative links into absolute
+// links by resolving them against the given base URL
+func (r *ListReport) Resolve(
+	base *url.URL,
+) *ListReport {
+	r.First = resolveLink(base, r.First)
+	r.Prev = resolveLink(base, r.Prev)
+	r.Next = resolveLink(base, r.Next)
+	r.Last = resolveLink(base, r.Last)
+	return r
+}
+
+func (r *ListReport) pages(
+	link func(search string, start, count uint) string,
+) (string, string) {
 	// store the prev URL query parameters if the start value
 	// is greater than zero
 	prev := ""
-	if start > 0 {
+	if r.Start > 0 {
 		// discover the previous page starting value
 		nstart := uint(0)
-		if count < start {
-			nstart = start - count
+		if r.Count < r.Start {
+			nstart = r.Start - r.Count
 		}
 		// compose the URL prev page query parameters
-		prev = fmt.Sprintf("?search=%s&start=%d&count=%d", search, nstart, count)
+		prev = link(r.Search, nstart, r.Count)
 	}
 	// store the next URL query parameters if the total number of
 	// record are greater than the current start plus the number of
 	// presented records
 	next := ""
-	if start+count < total {
+	if r.Start+r.Count < r.Total {
 		// compose the URL next page query parameters
-		next = fmt.Sprintf("?search=%s&start=%d&count=%d", search, start+count, count)
+		next = link(r.Search, r.Start+r.Count, r.Count)
 	}
-	// return the list report instance reference
-	return &ListReport{
-		Search: search,
-		Start:  start,
-		Count:  count,
-		Total:  total,
-		Prev:   prev,
-		Next:   next,
+	return prev, next
+}
+
+func listLink(
+	search string,
+	start,
+	count uint,
+) string {
+	return fmt.Sprintf("?search=%s&start=%d&count=%d", url.QueryEscape(search), start, count)
+}
+
+func resolveLink(
+	base *url.URL,
+	link string,
+) string {
+	// no-op if there is no base or link to be resolved
+	if base == nil || link == "" {
+		return link
+	}
+	// parse the link and resolve it against the base URL
+	ref, e := url.Parse(link)
+	if e != nil {
+		return link
 	}
+	return base.ResolveReference(ref).String()
 }
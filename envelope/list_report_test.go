@@ -1,6 +1,10 @@
 package envelope
 
-import "testing"
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
 
 func Test_NewListReport(t *testing.T) {
 	t.Run("store the search parameters", func(t *testing.T) {
@@ -85,7 +89,98 @@ func Test_NewListReport(t *testing.T) {
 				t.Errorf("stored the (%v) prev link when expecting (%v)", check, scenario.prev)
 			} else if check := report.Next; check != scenario.next {
 				t.Errorf("stored the (%v) prev link when expecting (%v)", check, scenario.next)
+			} else if report.First != "" || report.Last != "" {
+				t.Errorf("stored the unexpected (%v) and (%v) first and last links", report.First, report.Last)
 			}
 		}
 	})
+
+	t.Run("don't present the unset first and last links", func(t *testing.T) {
+		data, _ := json.Marshal(NewListReport("search", 0, 2, 10))
+		expected := `{"search":"search","start":0,"count":2,"total":10,"prev":"","next":"?search=search\u0026start=2\u0026count=2"}`
+
+		if check := string(data); check != expected {
+			t.Errorf("encoded (%v) when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_ListReport_SetPageLinks(t *testing.T) {
+	t.Run("escape the search terms", func(t *testing.T) {
+		report := NewListReport("a&b c", 2, 2, 10).SetPageLinks()
+
+		switch {
+		case report.First != "?search=a%26b+c&start=0&count=2":
+			t.Errorf("stored the (%v) first link", report.First)
+		case report.Prev != "?search=a%26b+c&start=0&count=2":
+			t.Errorf("stored the (%v) prev link", report.Prev)
+		case report.Next != "?search=a%26b+c&start=4&count=2":
+			t.Errorf("stored the (%v) next link", report.Next)
+		case report.Last != "?search=a%26b+c&start=8&count=2":
+			t.Errorf("stored the (%v) last link", report.Last)
+		}
+	})
+
+	t.Run("last page link", func(t *testing.T) {
+		scenarios := []struct {
+			count uint
+			total uint
+			last  string
+		}{
+			{ // empty list
+				count: uint(2),
+				total: uint(0),
+				last:  "?search=&start=0&count=2",
+			},
+			{ // no page size
+				count: uint(0),
+				total: uint(10),
+				last:  "?search=&start=0&count=0",
+			},
+			{ // incomplete last page
+				count: uint(3),
+				total: uint(10),
+				last:  "?search=&start=9&count=3",
+			},
+			{ // complete last page
+				count: uint(5),
+				total: uint(10),
+				last:  "?search=&start=5&count=5",
+			},
+		}
+
+		for _, scenario := range scenarios {
+			report := NewListReport("", 0, scenario.count, scenario.total).SetPageLinks()
+
+			if check := report.Last; check != scenario.last {
+				t.Errorf("stored the (%v) last link when expecting (%v)", check, scenario.last)
+			}
+		}
+	})
+}
+
+func Test_ListReport_Resolve(t *testing.T) {
+	t.Run("no-op on nil base", func(t *testing.T) {
+		report := NewListReport("search", 2, 2, 10).Resolve(nil)
+		expected := "?search=search&start=0&count=2"
+
+		if check := report.Prev; check != expected {
+			t.Errorf("stored the (%v) prev link when expecting (%v)", check, expected)
+		}
+	})
+
+	t.Run("resolve the links", func(t *testing.T) {
+		base, _ := url.Parse("https://domain.com/prefix/resource?search=other")
+		report := NewListReport("search", 0, 2, 10).SetPageLinks().Resolve(base)
+
+		if check := report.First; check != "https://domain.com/prefix/resource?search=search&start=0&count=2" {
+			t.Errorf("stored the (%v) first link", check)
+		} else if check := report.Prev; check != "" {
+			t.Errorf("stored the (%v) prev link", check)
+		} else if check := report.Next; check != "https://domain.com/prefix/resource?search=search&start=2&count=2" {
+			t.Errorf("stored the (%v) next link", check)
+		} else if check := report.Last; check != "https://domain.com/prefix/resource?search=search&start=8&count=2" {
+			t.Errorf("stored the (%v) last link", check)
+		}
+	})
 }
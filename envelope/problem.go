@@ -0,0 +1,85 @@
+package envelope
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+const (
+	// MIMEProblemJSON defines the RFC 7807 JSON problem details mime type.
+	MIMEProblemJSON = "application/problem+json"
+
+	// MIMEProblemXML defines the RFC 7807 XML problem details mime type.
+	MIMEProblemXML = "application/problem+xml"
+
+	// ProblemBlankType defines the RFC 7807 default problem type.
+	ProblemBlankType = "about:blank"
+)
+
+// Problem defines the structure of an RFC 7807 problem details response
+// with the envelope status errors presented in the errors extension member.
+type Problem struct {
+	XMLName  xml.Name       `json:"-" xml:"urn:ietf:rfc:7807 problem"`
+	Type     string         `json:"type" xml:"type"`
+	Title    string         `json:"title" xml:"title"`
+	Status   int            `json:"status" xml:"status"`
+	Detail   string         `json:"detail,omitempty" xml:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty" xml:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors,omitempty" xml:"errors>error,omitempty"`
+}
+
+// ProblemError defines the structure of a problem details
+// errors extension member entry.
+type ProblemError struct {
+	Code    string `json:"code" xml:"code,attr"`
+	Message string `json:"message" xml:"message,attr"`
+	Param   int    `json:"param,omitempty" xml:"param,attr,omitempty"`
+}
+
+// NewProblem instantiates a new problem details structure populated
+// with the information of the given envelope.
+func NewProblem(
+	env *Envelope,
+) (*Problem, error) {
+	// check the envelope argument reference
+	if env == nil {
+		return nil, errNilPointer("env")
+	}
+	// initialize the problem structure
+	p := &Problem{
+		Type:   ProblemBlankType,
+		Title:  http.StatusText(env.StatusCode),
+		Status: env.StatusCode,
+	}
+	// map the envelope status errors into the errors extension member
+	if env.Status != nil {
+		for _, e := range env.Status.Errors {
+			p.Errors = append(p.Errors, ProblemError{
+				Code:    e.Code,
+				Message: e.Message,
+				Param:   e.Param,
+			})
+		}
+	}
+	// use the first error message as the problem detail
+	if len(p.Errors) != 0 {
+		p.Detail = p.Errors[0].Message
+	}
+	return p, nil
+}
+
+// SetType assigns the problem type URI reference.
+func (p *Problem) SetType(
+	val string,
+) *Problem {
+	p.Type = val
+	return p
+}
+
+// SetInstance assigns the problem occurrence URI reference.
+func (p *Problem) SetInstance(
+	val string,
+) *Problem {
+	p.Instance = val
+	return p
+}
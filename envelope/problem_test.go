@@ -0,0 +1,101 @@
+package envelope
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewProblem(t *testing.T) {
+	t.Run("nil envelope", func(t *testing.T) {
+		if p, e := NewProblem(nil); p != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("construct from envelope without errors", func(t *testing.T) {
+		p, e := NewProblem(NewEnvelope(404, nil))
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case p.Type != ProblemBlankType:
+			t.Errorf("stored the (%v) type", p.Type)
+		case p.Title != "Not Found":
+			t.Errorf("stored the (%v) title", p.Title)
+		case p.Status != 404:
+			t.Errorf("stored the (%v) status", p.Status)
+		case p.Detail != "":
+			t.Errorf("stored the (%v) detail", p.Detail)
+		case len(p.Errors) != 0:
+			t.Errorf("stored the (%v) errors", p.Errors)
+		}
+	})
+
+	t.Run("construct from envelope with errors", func(t *testing.T) {
+		env := NewEnvelope(400, nil).
+			AddError(NewStatusError(1, "error message 1").SetParam(2)).
+			AddError(NewStatusError(3, "error message 2")).
+			SetService(4)
+
+		p, e := NewProblem(env)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case p.Title != "Bad Request":
+			t.Errorf("stored the (%v) title", p.Title)
+		case p.Status != 400:
+			t.Errorf("stored the (%v) status", p.Status)
+		case p.Detail != "error message 1":
+			t.Errorf("stored the (%v) detail", p.Detail)
+		case len(p.Errors) != 2:
+			t.Errorf("stored the (%v) errors", p.Errors)
+		case p.Errors[0] != ProblemError{Code: "s:4.p:2.c:1", Message: "error message 1", Param: 2}:
+			t.Errorf("stored the (%v) first error", p.Errors[0])
+		case p.Errors[1] != ProblemError{Code: "s:4.c:3", Message: "error message 2"}:
+			t.Errorf("stored the (%v) second error", p.Errors[1])
+		}
+	})
+}
+
+func Test_Problem_SetType(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		p, _ := NewProblem(NewEnvelope(400, nil))
+		if check := p.SetType("type").Type; check != "type" {
+			t.Errorf("stored the (%v) type", check)
+		}
+	})
+}
+
+func Test_Problem_SetInstance(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		p, _ := NewProblem(NewEnvelope(400, nil))
+		if check := p.SetInstance("/resource").Instance; check != "/resource" {
+			t.Errorf("stored the (%v) instance", check)
+		}
+	})
+}
+
+func Test_Problem_Marshal(t *testing.T) {
+	env := NewEnvelope(400, nil).AddError(NewStatusError(1, "error message").SetParam(2))
+	p, _ := NewProblem(env)
+	p.SetInstance("/resource")
+
+	t.Run("json", func(t *testing.T) {
+		expected := `{"type":"about:blank","title":"Bad Request","status":400,"detail":"error message","instance":"/resource","errors":[{"code":"p:2.c:1","message":"error message","param":2}]}`
+		if check, _ := json.Marshal(p); string(check) != expected {
+			t.Errorf("marshaled the problem into (%s) when expecting (%v)", check, expected)
+		}
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		expected := `<problem xmlns="urn:ietf:rfc:7807"><type>about:blank</type><title>Bad Request</title><status>400</status><detail>error message</detail><instance>/resource</instance><errors><error code="p:2.c:1" message="error message" param="2"></error></errors></problem>`
+		if check, _ := xml.Marshal(p); string(check) != expected {
+			t.Errorf("marshaled the problem into (%s) when expecting (%v)", check, expected)
+		}
+	})
+}
@@ -6,16 +6,35 @@ import (
 	"strings"
 )
 
+const (
+	// SeverityError defines the severity of an error that prevented
+	// the request execution.
+	SeverityError = "error"
+
+	// SeverityWarning defines the severity of an error that didn't
+	// prevent the request execution but should be reviewed.
+	SeverityWarning = "warning"
+
+	// SeverityInfo defines the severity of an informative error entry.
+	SeverityInfo = "info"
+)
+
 // StatusError defines the structure to manipulate an error structure
 // that hold the information of an execution error and be assigned to the
 // response status error list.
 type StatusError struct {
-	Service  int    `json:"-" xml:"-"`
-	Endpoint int    `json:"-" xml:"-"`
-	Param    int    `json:"-" xml:"-"`
-	Error    string `json:"-" xml:"-"`
-	Code     string `json:"code" xml:"code"`
-	Message  string `json:"message" xml:"message"`
+	Service  int                    `json:"-" xml:"-"`
+	Endpoint int                    `json:"-" xml:"-"`
+	Param    int                    `json:"-" xml:"-"`
+	Error    string                 `json:"-" xml:"-"`
+	Code     string                 `json:"code" xml:"code"`
+	Message  string                 `json:"message" xml:"message"`
+	Field    string                 `json:"field,omitempty" xml:"field,omitempty"`
+	Value    interface{}            `json:"value,omitempty" xml:"value,omitempty"`
+	DocURL   string                 `json:"docUrl,omitempty" xml:"docUrl,omitempty"`
+	Severity string                 `json:"severity,omitempty" xml:"severity,omitempty"`
+	Meta     map[string]interface{} `json:"meta,omitempty" xml:"-"`
+	Raw      string                 `json:"raw,omitempty" xml:"raw,omitempty"`
 }
 
 // NewStatusError instantiates a new error instance.
@@ -69,6 +88,60 @@ func (e *StatusError) SetMessage(
 	return e
 }
 
+// SetField assigns the path of the request field that originated the error.
+func (e *StatusError) SetField(
+	field string,
+) *StatusError {
+	e.Field = field
+	return e
+}
+
+// SetValue assigns the rejected value that originated the error.
+func (e *StatusError) SetValue(
+	val interface{},
+) *StatusError {
+	e.Value = val
+	return e
+}
+
+// SetDocURL assigns the URL of the documentation describing the error.
+func (e *StatusError) SetDocURL(
+	url string,
+) *StatusError {
+	e.DocURL = url
+	return e
+}
+
+// SetSeverity assigns the severity of the error.
+func (e *StatusError) SetSeverity(
+	severity string,
+) *StatusError {
+	e.Severity = severity
+	return e
+}
+
+// SetMeta assigns an arbitrary metadata value to the error.
+func (e *StatusError) SetMeta(
+	key string,
+	val interface{},
+) *StatusError {
+	if e.Meta == nil {
+		e.Meta = map[string]interface{}{}
+	}
+	e.Meta[key] = val
+	return e
+}
+
+// SetRaw assigns the raw description of the error that originated the
+// status error. This information should only be exposed in
+// non-production environments.
+func (e *StatusError) SetRaw(
+	err interface{},
+) *StatusError {
+	e.Raw = fmt.Sprintf("%v", err)
+	return e
+}
+
 // GetCode retrieves the composed code of the error
 func (e StatusError) GetCode() string {
 	return e.Code
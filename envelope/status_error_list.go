@@ -2,6 +2,8 @@ package envelope
 
 import (
 	"encoding/xml"
+	"fmt"
+	"sort"
 )
 
 // StatusErrorList defines a type of data  that holds a list
@@ -20,13 +22,34 @@ func (s StatusErrorList) MarshalXML(
 		// create the iterated error starting tag name
 		name := xml.Name{Space: "", Local: "error"}
 		// encode the error instance tag with the code and message attributes
-		_ = e.EncodeToken(xml.StartElement{
-			Name: name,
-			Attr: []xml.Attr{
-				{Name: xml.Name{Local: "code"}, Value: v.Code},
-				{Name: xml.Name{Local: "message"}, Value: v.Message},
-			},
-		})
+		// followed by the optional error information attributes
+		attrs := []xml.Attr{
+			{Name: xml.Name{Local: "code"}, Value: v.Code},
+			{Name: xml.Name{Local: "message"}, Value: v.Message},
+		}
+		attrs = appendAttr(attrs, "field", v.Field)
+		if v.Value != nil {
+			attrs = appendAttr(attrs, "value", fmt.Sprintf("%v", v.Value))
+		}
+		attrs = appendAttr(attrs, "docUrl", v.DocURL)
+		attrs = appendAttr(attrs, "severity", v.Severity)
+		attrs = appendAttr(attrs, "raw", v.Raw)
+		_ = e.EncodeToken(xml.StartElement{Name: name, Attr: attrs})
+		// encode the error metadata entries sorted by key
+		keys := make([]string, 0, len(v.Meta))
+		for k := range v.Meta {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			meta := xml.StartElement{
+				Name: xml.Name{Local: "meta"},
+				Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: k}},
+			}
+			_ = e.EncodeToken(meta)
+			_ = e.EncodeToken(xml.CharData(fmt.Sprintf("%v", v.Meta[k])))
+			_ = e.EncodeToken(meta.End())
+		}
 		// encode the terminating error tag
 		_ = e.EncodeToken(xml.EndElement{Name: name})
 	}
@@ -35,3 +58,89 @@ func (s StatusErrorList) MarshalXML(
 	_ = e.Flush()
 	return nil
 }
+
+func appendAttr(
+	attrs []xml.Attr,
+	name,
+	value string,
+) []xml.Attr {
+	if value == "" {
+		return attrs
+	}
+	return append(attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+}
+
+// UnmarshalXML deserialize the error list from a xml string
+func (s *StatusErrorList) UnmarshalXML(
+	d *xml.Decoder,
+	start xml.StartElement,
+) error {
+	list := StatusErrorList{}
+	for {
+		// retrieve the next list token
+		token, e := d.Token()
+		if e != nil {
+			return e
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			// decode the iterated error element
+			se, e := unmarshalStatusError(d, t)
+			if e != nil {
+				return e
+			}
+			list = append(list, se)
+		case xml.EndElement:
+			// store the list when reaching the terminating list tag
+			*s = list
+			return nil
+		}
+	}
+}
+
+func unmarshalStatusError(
+	d *xml.Decoder,
+	start xml.StartElement,
+) (*StatusError, error) {
+	// decode the error attributes
+	se := &StatusError{}
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "code":
+			se.Code = attr.Value
+		case "message":
+			se.Message = attr.Value
+		case "field":
+			se.Field = attr.Value
+		case "value":
+			se.Value = attr.Value
+		case "docUrl":
+			se.DocURL = attr.Value
+		case "severity":
+			se.Severity = attr.Value
+		case "raw":
+			se.Raw = attr.Value
+		}
+	}
+	// decode the error metadata entries
+	for {
+		token, e := d.Token()
+		if e != nil {
+			return nil, e
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var value string
+			if e := d.DecodeElement(&value, &t); e != nil {
+				return nil, e
+			}
+			for _, attr := range t.Attr {
+				if attr.Name.Local == "key" {
+					se.SetMeta(attr.Value, value)
+				}
+			}
+		case xml.EndElement:
+			return se, nil
+		}
+	}
+}
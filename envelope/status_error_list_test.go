@@ -2,6 +2,7 @@ package envelope
 
 import (
 	"encoding/xml"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -56,4 +57,107 @@ func Test_StatusErrorList_MarshalXML(t *testing.T) {
 			t.Errorf("marshaled the list into (%v) when expecting (%v)", check, expected)
 		}
 	})
+
+	t.Run("element with metadata", func(t *testing.T) {
+		name := "start"
+		buffer := strings.Builder{}
+		start := xml.StartElement{Name: xml.Name{Local: name}}
+		list := StatusErrorList{
+			NewStatusError(1, "error message").
+				SetField("name").
+				SetValue(12).
+				SetDocURL("http://domain.com").
+				SetSeverity(SeverityWarning).
+				SetMeta("min", 3).
+				SetMeta("max", 10).
+				SetRaw("raw error"),
+		}
+		expected := `<start>`
+		expected += `<error code="c:1" message="error message" field="name" value="12" docUrl="http://domain.com" severity="warning" raw="raw error">`
+		expected += `<meta key="max">10</meta><meta key="min">3</meta>`
+		expected += `</error>`
+		expected += `</start>`
+
+		if err := list.MarshalXML(xml.NewEncoder(&buffer), start); err != nil {
+			t.Errorf("returned the ujnexpected error (%v)", err)
+		} else if check := buffer.String(); check != expected {
+			t.Errorf("marshaled the list into (%v) when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_StatusErrorList_UnmarshalXML(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		list := StatusErrorList{NewStatusError(1, "message")}
+
+		if err := xml.Unmarshal([]byte("<start></start>"), &list); err != nil {
+			t.Errorf("returned the unexpected error (%v)", err)
+		} else if list == nil || len(list) != 0 {
+			t.Errorf("unmarshaled the (%v) list", list)
+		}
+	})
+
+	t.Run("invalid xml", func(t *testing.T) {
+		list := StatusErrorList{}
+
+		if err := xml.Unmarshal([]byte("<start><error>"), &list); err == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		list := StatusErrorList{}
+
+		if err := xml.Unmarshal([]byte(`<start><error><meta key="key"><value></meta></error></start>`), &list); err == nil {
+			t.Error("didn't returned the expected error")
+		}
+	})
+
+	t.Run("marshaled list", func(t *testing.T) {
+		expected := StatusErrorList{
+			NewStatusError(1, "error message 1").SetService(2).SetEndpoint(3),
+			NewStatusError(2, "error message 2").
+				SetField("name").
+				SetValue("12").
+				SetDocURL("http://domain.com").
+				SetSeverity(SeverityWarning).
+				SetMeta("min", "3").
+				SetRaw("raw error"),
+		}
+		data, _ := xml.Marshal(struct {
+			XMLName xml.Name        `xml:"start"`
+			Errors  StatusErrorList `xml:"error"`
+		}{Errors: expected})
+		check := struct {
+			XMLName xml.Name        `xml:"start"`
+			Errors  StatusErrorList `xml:"error"`
+		}{}
+
+		if err := xml.Unmarshal(data, &check); err != nil {
+			t.Errorf("returned the unexpected error (%v)", err)
+		} else if len(check.Errors) != 2 {
+			t.Errorf("unmarshaled the (%v) list", check.Errors)
+		} else {
+			for i, e := range check.Errors {
+				switch {
+				case e.Code != expected[i].Code:
+					t.Errorf("unmarshaled the (%v) code when expecting (%v)", e.Code, expected[i].Code)
+				case e.Message != expected[i].Message:
+					t.Errorf("unmarshaled the (%v) message when expecting (%v)", e.Message, expected[i].Message)
+				case e.Field != expected[i].Field:
+					t.Errorf("unmarshaled the (%v) field when expecting (%v)", e.Field, expected[i].Field)
+				case e.Value != expected[i].Value:
+					t.Errorf("unmarshaled the (%v) value when expecting (%v)", e.Value, expected[i].Value)
+				case e.DocURL != expected[i].DocURL:
+					t.Errorf("unmarshaled the (%v) doc URL when expecting (%v)", e.DocURL, expected[i].DocURL)
+				case e.Severity != expected[i].Severity:
+					t.Errorf("unmarshaled the (%v) severity when expecting (%v)", e.Severity, expected[i].Severity)
+				case e.Raw != expected[i].Raw:
+					t.Errorf("unmarshaled the (%v) raw error when expecting (%v)", e.Raw, expected[i].Raw)
+				case !reflect.DeepEqual(e.Meta, expected[i].Meta):
+					t.Errorf("unmarshaled the (%v) metadata when expecting (%v)", e.Meta, expected[i].Meta)
+				}
+			}
+		}
+	})
 }
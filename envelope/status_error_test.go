@@ -1,7 +1,9 @@
 package envelope
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -141,6 +143,109 @@ func Test_StatusError_SetMessage(t *testing.T) {
 	})
 }
 
+func Test_StatusError_SetField(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		field := "user.name"
+		e := NewStatusError(1, "message").SetField(field)
+
+		if check := e.Field; check != field {
+			t.Errorf("stored the (%v) field instead of expected(%v)", check, field)
+		}
+	})
+}
+
+func Test_StatusError_SetValue(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		value := 123
+		e := NewStatusError(1, "message").SetValue(value)
+
+		if check := e.Value; check != value {
+			t.Errorf("stored the (%v) value instead of expected(%v)", check, value)
+		}
+	})
+}
+
+func Test_StatusError_SetDocURL(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		url := "http://domain.com/docs/errors/1"
+		e := NewStatusError(1, "message").SetDocURL(url)
+
+		if check := e.DocURL; check != url {
+			t.Errorf("stored the (%v) documentation URL instead of expected(%v)", check, url)
+		}
+	})
+}
+
+func Test_StatusError_SetSeverity(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		e := NewStatusError(1, "message").SetSeverity(SeverityWarning)
+
+		if check := e.Severity; check != SeverityWarning {
+			t.Errorf("stored the (%v) severity instead of expected(%v)", check, SeverityWarning)
+		}
+	})
+}
+
+func Test_StatusError_SetMeta(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		e := NewStatusError(1, "message").SetMeta("key1", "value1").SetMeta("key2", 2)
+		expected := map[string]interface{}{"key1": "value1", "key2": 2}
+
+		if check := e.Meta; !reflect.DeepEqual(check, expected) {
+			t.Errorf("stored the (%v) metadata instead of expected(%v)", check, expected)
+		}
+	})
+
+	t.Run("override", func(t *testing.T) {
+		e := NewStatusError(1, "message").SetMeta("key", "value1").SetMeta("key", "value2")
+
+		if check := e.Meta["key"]; check != "value2" {
+			t.Errorf("stored the (%v) metadata value instead of expected(value2)", check)
+		}
+	})
+}
+
+func Test_StatusError_SetRaw(t *testing.T) {
+	t.Run("assign", func(t *testing.T) {
+		err := fmt.Errorf("error message")
+		e := NewStatusError(1, "message").SetRaw(err)
+
+		if check := e.Raw; check != err.Error() {
+			t.Errorf("stored the (%v) raw error instead of expected(%v)", check, err)
+		}
+	})
+}
+
+func Test_StatusError_MarshalJSON(t *testing.T) {
+	t.Run("omit empty metadata", func(t *testing.T) {
+		e := NewStatusError(1, "message")
+		expected := `{"code":"c:1","message":"message"}`
+
+		if check, err := json.Marshal(e); err != nil {
+			t.Errorf("returned the unexpected error (%v)", err)
+		} else if string(check) != expected {
+			t.Errorf("marshaled the error into (%v) when expecting (%v)", string(check), expected)
+		}
+	})
+
+	t.Run("marshal metadata", func(t *testing.T) {
+		e := NewStatusError(1, "message").
+			SetField("name").
+			SetValue("").
+			SetDocURL("http://domain.com").
+			SetSeverity(SeverityError).
+			SetMeta("min", 3).
+			SetRaw("raw error")
+		expected := `{"code":"c:1","message":"message","field":"name","value":"","docUrl":"http://domain.com","severity":"error","meta":{"min":3},"raw":"raw error"}`
+
+		if check, err := json.Marshal(e); err != nil {
+			t.Errorf("returned the unexpected error (%v)", err)
+		} else if string(check) != expected {
+			t.Errorf("marshaled the error into (%v) when expecting (%v)", string(check), expected)
+		}
+	})
+}
+
 func Test_StatusError_GetCode(t *testing.T) {
 	t.Run("retrieval", func(t *testing.T) {
 		service := 12
@@ -0,0 +1,51 @@
+package envelope
+
+// StreamEnvelope defines a response envelope where the data records are
+// provided through a channel, so that they can be written to the response
+// as they are produced. An error sent through the channel will terminate
+// the stream and will be reported in the envelope status. The producer
+// must close the channel when done, even if the stream is terminated
+// earlier by an error or by the request cancellation, since the remaining
+// records are then discarded until the channel is closed. A producer
+// should also select on the request context Done channel to stop
+// producing records of a cancelled request.
+type StreamEnvelope struct {
+	StatusCode   int
+	Items        <-chan interface{}
+	ListReport   *ListReport
+	CursorReport *CursorReport
+}
+
+// NewStreamEnvelope instantiates a new response stream envelope.
+func NewStreamEnvelope(
+	statusCode int,
+	items <-chan interface{},
+) *StreamEnvelope {
+	return &StreamEnvelope{
+		StatusCode: statusCode,
+		Items:      items,
+	}
+}
+
+// GetStatusCode returned the stored enveloped response status code
+func (s StreamEnvelope) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// SetListReport assign the list report to the envelope. The report can be
+// assigned until the items channel is closed.
+func (s *StreamEnvelope) SetListReport(
+	listReport *ListReport,
+) *StreamEnvelope {
+	s.ListReport = listReport
+	return s
+}
+
+// SetCursorReport assign the keyset pagination report to the envelope.
+// The report can be assigned until the items channel is closed.
+func (s *StreamEnvelope) SetCursorReport(
+	cursorReport *CursorReport,
+) *StreamEnvelope {
+	s.CursorReport = cursorReport
+	return s
+}
@@ -0,0 +1,44 @@
+package envelope
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func Test_NewStreamEnvelope(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		items := make(chan interface{})
+		env := NewStreamEnvelope(http.StatusOK, items)
+		switch {
+		case env.GetStatusCode() != http.StatusOK:
+			t.Errorf("stored the (%v) status code", env.GetStatusCode())
+		case env.Items != (<-chan interface{})(items):
+			t.Error("didn't stored the items channel")
+		case env.ListReport != nil:
+			t.Errorf("stored the unexpected (%v) list report", env.ListReport)
+		case env.CursorReport != nil:
+			t.Errorf("stored the unexpected (%v) cursor report", env.CursorReport)
+		}
+	})
+}
+
+func Test_StreamEnvelope_SetListReport(t *testing.T) {
+	t.Run("assign the list report", func(t *testing.T) {
+		report := NewListReport("search", 0, 10, 20)
+
+		if check := NewStreamEnvelope(http.StatusOK, nil).SetListReport(report).ListReport; check != report {
+			t.Errorf("stored the (%v) list report", check)
+		}
+	})
+}
+
+func Test_StreamEnvelope_SetCursorReport(t *testing.T) {
+	t.Run("assign the cursor report", func(t *testing.T) {
+		report := NewCursorReport(url.Values{}, 10, "", "next")
+
+		if check := NewStreamEnvelope(http.StatusOK, nil).SetCursorReport(report).CursorReport; check != report {
+			t.Errorf("stored the (%v) cursor report", check)
+		}
+	})
+}
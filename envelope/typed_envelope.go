@@ -0,0 +1,98 @@
+package envelope
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// IEnvelope defines the interface of a structure that can be
+// converted into a response envelope.
+type IEnvelope interface {
+	Envelope() *Envelope
+}
+
+// TypedEnvelope defines a response envelope structure with a statically
+// typed data section, mostly used to decode received envelopes.
+type TypedEnvelope[T any] struct {
+	XMLName      xml.Name      `json:"-" xml:"envelope"`
+	StatusCode   int           `json:"-" xml:"-"`
+	Status       *Status       `json:"status" xml:"status"`
+	ListReport   *ListReport   `json:"report,omitempty" xml:"report,omitempty"`
+	CursorReport *CursorReport `json:"cursor,omitempty" xml:"cursor,omitempty"`
+	Links        LinkList      `json:"_links,omitempty" xml:"link,omitempty"`
+	Items        BatchItemList `json:"items,omitempty" xml:"items,omitempty"`
+	Data         T             `json:"data,omitempty" xml:"data,omitempty"`
+}
+
+var _ IEnvelope = &TypedEnvelope[interface{}]{}
+
+// NewTypedEnvelope instantiates a new typed response data envelope structure.
+func NewTypedEnvelope[T any](
+	statusCode int,
+	data T,
+) *TypedEnvelope[T] {
+	return &TypedEnvelope[T]{
+		StatusCode: statusCode,
+		Status:     NewStatus(),
+		Data:       data,
+	}
+}
+
+// Success check if the envelope status reports a successful response.
+func (s TypedEnvelope[T]) Success() bool {
+	return s.Status == nil || s.Status.Success
+}
+
+// Errors retrieves the envelope status error list.
+func (s TypedEnvelope[T]) Errors() StatusErrorList {
+	if s.Status == nil {
+		return StatusErrorList{}
+	}
+	return s.Status.Errors
+}
+
+// Envelope converts the typed envelope into an untyped envelope
+// that can be handled by the envelope middleware.
+func (s TypedEnvelope[T]) Envelope() *Envelope {
+	env := NewEnvelope(s.StatusCode, s.Data, s.ListReport)
+	env.CursorReport = s.CursorReport
+	env.Links = s.Links
+	env.Items = s.Items
+	if s.Status != nil {
+		env.Status = s.Status
+	}
+	return env
+}
+
+// OK instantiates a new successful response envelope with the given data.
+func OK[T any](
+	data T,
+) *Envelope {
+	return NewEnvelope(http.StatusOK, data)
+}
+
+// Created instantiates a new resource creation response envelope
+// with the given data.
+func Created[T any](
+	data T,
+) *Envelope {
+	return NewEnvelope(http.StatusCreated, data)
+}
+
+// Page instantiates a new successful response envelope with the given
+// list of records and the list report of the page.
+func Page[T any](
+	data []T,
+	report *ListReport,
+) *Envelope {
+	return NewEnvelope(http.StatusOK, data, report)
+}
+
+// CursorPage instantiates a new successful response envelope with the
+// given list of records and the keyset pagination report of the page.
+func CursorPage[T any](
+	data []T,
+	report *CursorReport,
+) *Envelope {
+	return NewEnvelope(http.StatusOK, data).SetCursorReport(report)
+}
@@ -0,0 +1,147 @@
+package envelope
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func Test_NewTypedEnvelope(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		sut := NewTypedEnvelope(http.StatusOK, []int{1, 2})
+		switch {
+		case sut.StatusCode != http.StatusOK:
+			t.Errorf("stored the (%v) status code", sut.StatusCode)
+		case sut.Status == nil || !sut.Status.Success:
+			t.Errorf("stored the (%v) status", sut.Status)
+		case !reflect.DeepEqual(sut.Data, []int{1, 2}):
+			t.Errorf("stored the (%v) data", sut.Data)
+		}
+	})
+}
+
+func Test_TypedEnvelope_Success(t *testing.T) {
+	t.Run("nil status", func(t *testing.T) {
+		if !(TypedEnvelope[int]{}).Success() {
+			t.Error("didn't reported a successful envelope")
+		}
+	})
+
+	t.Run("successful status", func(t *testing.T) {
+		if !NewTypedEnvelope(http.StatusOK, 1).Success() {
+			t.Error("didn't reported a successful envelope")
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		sut := NewTypedEnvelope(http.StatusOK, 1)
+		sut.Status.AddError(NewStatusError(1, "message"))
+
+		if sut.Success() {
+			t.Error("reported a successful envelope")
+		}
+	})
+}
+
+func Test_TypedEnvelope_Errors(t *testing.T) {
+	t.Run("nil status", func(t *testing.T) {
+		if check := (TypedEnvelope[int]{}).Errors(); check == nil || len(check) != 0 {
+			t.Errorf("returned the (%v) error list", check)
+		}
+	})
+
+	t.Run("status errors", func(t *testing.T) {
+		sut := NewTypedEnvelope(http.StatusOK, 1)
+		sut.Status.AddError(NewStatusError(1, "message"))
+
+		if check := sut.Errors(); len(check) != 1 || check[0].Message != "message" {
+			t.Errorf("returned the (%v) error list", check)
+		}
+	})
+}
+
+func Test_TypedEnvelope_Envelope(t *testing.T) {
+	t.Run("convert", func(t *testing.T) {
+		listReport := NewListReport("search", 0, 10, 20)
+		cursorReport := NewCursorReport(url.Values{}, 10, "", "next")
+		sut := NewTypedEnvelope(http.StatusCreated, "data")
+		sut.ListReport = listReport
+		sut.CursorReport = cursorReport
+		sut.Links = LinkList{{Rel: LinkSelf, Href: "/resource"}}
+
+		env := sut.Envelope()
+		switch {
+		case env.StatusCode != http.StatusCreated:
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		case env.Status != sut.Status:
+			t.Errorf("stored the (%v) status", env.Status)
+		case env.ListReport != listReport:
+			t.Errorf("stored the (%v) list report", env.ListReport)
+		case env.CursorReport != cursorReport:
+			t.Errorf("stored the (%v) cursor report", env.CursorReport)
+		case !reflect.DeepEqual(env.Links, sut.Links):
+			t.Errorf("stored the (%v) links", env.Links)
+		case env.Data != "data":
+			t.Errorf("stored the (%v) data", env.Data)
+		}
+	})
+
+	t.Run("convert without status", func(t *testing.T) {
+		if env := (TypedEnvelope[int]{}).Envelope(); env.Status == nil {
+			t.Error("didn't initialized the envelope status")
+		}
+	})
+}
+
+func Test_OK(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		env := OK("data")
+		if env.StatusCode != http.StatusOK {
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		} else if env.Data != "data" {
+			t.Errorf("stored the (%v) data", env.Data)
+		}
+	})
+}
+
+func Test_Created(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		env := Created("data")
+		if env.StatusCode != http.StatusCreated {
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		} else if env.Data != "data" {
+			t.Errorf("stored the (%v) data", env.Data)
+		}
+	})
+}
+
+func Test_Page(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		report := NewListReport("search", 0, 10, 20)
+		env := Page([]int{1, 2}, report)
+		switch {
+		case env.StatusCode != http.StatusOK:
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		case !reflect.DeepEqual(env.Data, []int{1, 2}):
+			t.Errorf("stored the (%v) data", env.Data)
+		case env.ListReport != report:
+			t.Errorf("stored the (%v) list report", env.ListReport)
+		}
+	})
+}
+
+func Test_CursorPage(t *testing.T) {
+	t.Run("construct", func(t *testing.T) {
+		report := NewCursorReport(url.Values{}, 10, "", "next")
+		env := CursorPage([]int{1, 2}, report)
+		switch {
+		case env.StatusCode != http.StatusOK:
+			t.Errorf("stored the (%v) status code", env.StatusCode)
+		case !reflect.DeepEqual(env.Data, []int{1, 2}):
+			t.Errorf("stored the (%v) data", env.Data)
+		case env.CursorReport != report:
+			t.Errorf("stored the (%v) cursor report", env.CursorReport)
+		}
+	})
+}
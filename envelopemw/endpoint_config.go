@@ -0,0 +1,80 @@
+package envelopemw
+
+import (
+	"net/http"
+
+	"github.com/happyhippyhippo/slate/config"
+)
+
+// endpointConfig defines the envelope handling configuration
+// of a single endpoint.
+type endpointConfig struct {
+	id       int
+	accept   []string
+	envelope bool
+	status   int
+	problem  bool
+}
+
+// newEndpointConfig will parse the endpoint envelope handling
+// configuration from the given endpoint configuration block. An accepted
+// format list with a non-string element results in an invalid accept
+// list error, instead of the element being silently dropped, and a
+// default status outside the 100-599 range results in an invalid
+// status error.
+func newEndpointConfig(
+	cfg config.IConfig,
+) (*endpointConfig, error) {
+	// retrieve the endpoint accepted format list that
+	// overrides the service accepted format list, discarding the
+	// whole configuration if any element is not a string
+	var accept []string
+	if cfg.Has("accept") {
+		list, e := cfg.List("accept")
+		if e != nil {
+			return nil, errInvalidAcceptList("accept", map[string]interface{}{"error": e})
+		}
+		for _, v := range list {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, errInvalidAcceptList(list, map[string]interface{}{"value": v})
+			}
+			accept = append(accept, tv)
+		}
+	}
+	// retrieve the success response enveloping flag
+	enveloped, e := cfg.Bool("envelope", true)
+	if e != nil {
+		return nil, e
+	}
+	// retrieve the default success response status
+	status, e := cfg.Int("status", http.StatusOK)
+	if e != nil {
+		return nil, e
+	}
+	if status < 100 || status > 599 {
+		return nil, errInvalidStatus(status)
+	}
+	// retrieve the problem details rendering flag
+	problem, e := cfg.Bool("problem", ProblemDetails)
+	if e != nil {
+		return nil, e
+	}
+	return &endpointConfig{
+		accept:   accept,
+		envelope: enveloped,
+		status:   status,
+		problem:  problem,
+	}, nil
+}
+
+// formats will retrieve the endpoint accepted format list, or the given
+// service accepted format list if the endpoint don't define a non-empty one.
+func (c endpointConfig) formats(
+	accepted []string,
+) []string {
+	if len(c.accept) != 0 {
+		return c.accept
+	}
+	return accepted
+}
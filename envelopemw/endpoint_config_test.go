@@ -0,0 +1,132 @@
+package envelopemw
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+)
+
+func Test_newEndpointConfig(t *testing.T) {
+	t.Run("invalid accept list", func(t *testing.T) {
+		scenarios := []struct {
+			test   string
+			accept interface{}
+		}{
+			{ // non-list value
+				test:   "non-list value",
+				accept: "string",
+			},
+			{ // non-string element
+				test:   "non-string element",
+				accept: []interface{}{gin.MIMEXML, 123},
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				if _, e := newEndpointConfig(config.Config{"accept": s.accept}); e == nil {
+					t.Error("didn't returned the expected error")
+				} else if !errors.Is(e, ErrInvalidAcceptList) {
+					t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidAcceptList)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid envelope flag", func(t *testing.T) {
+		if _, e := newEndpointConfig(config.Config{"envelope": "string"}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		if _, e := newEndpointConfig(config.Config{"status": "string"}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("out of range status", func(t *testing.T) {
+		for _, status := range []int{0, 99, 600, -200} {
+			if _, e := newEndpointConfig(config.Config{"status": status}); e == nil {
+				t.Errorf("didn't returned the expected error for the (%v) status", status)
+			} else if !errors.Is(e, ErrInvalidStatus) {
+				t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidStatus)
+			}
+		}
+	})
+
+	t.Run("invalid problem flag", func(t *testing.T) {
+		if _, e := newEndpointConfig(config.Config{"problem": "string"}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("default values", func(t *testing.T) {
+		prev := ProblemDetails
+		ProblemDetails = true
+		defer func() { ProblemDetails = prev }()
+
+		sut, e := newEndpointConfig(config.Config{})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case sut.accept != nil:
+			t.Errorf("stored the (%v) accept list", sut.accept)
+		case !sut.envelope:
+			t.Error("didn't stored the envelope flag")
+		case sut.status != http.StatusOK:
+			t.Errorf("stored the (%v) status", sut.status)
+		case !sut.problem:
+			t.Error("didn't defaulted to the environment problem flag")
+		}
+	})
+
+	t.Run("configured values", func(t *testing.T) {
+		sut, e := newEndpointConfig(config.Config{
+			"accept":   []interface{}{gin.MIMEXML},
+			"envelope": false,
+			"status":   http.StatusCreated,
+			"problem":  true,
+		})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case !reflect.DeepEqual(sut.accept, []string{gin.MIMEXML}):
+			t.Errorf("stored the (%v) accept list", sut.accept)
+		case sut.envelope:
+			t.Error("didn't stored the envelope flag")
+		case sut.status != http.StatusCreated:
+			t.Errorf("stored the (%v) status", sut.status)
+		case !sut.problem:
+			t.Error("didn't stored the problem flag")
+		}
+	})
+}
+
+func Test_endpointConfig_formats(t *testing.T) {
+	accepted := []string{gin.MIMEJSON}
+
+	t.Run("use the service formats if not overridden", func(t *testing.T) {
+		if check := (endpointConfig{}).formats(accepted); !reflect.DeepEqual(check, accepted) {
+			t.Errorf("retrieved the (%v) formats", check)
+		}
+	})
+
+	t.Run("use the endpoint formats", func(t *testing.T) {
+		expected := []string{gin.MIMEXML}
+		if check := (endpointConfig{accept: expected}).formats(accepted); !reflect.DeepEqual(check, expected) {
+			t.Errorf("retrieved the (%v) formats", check)
+		}
+	})
+}
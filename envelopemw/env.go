@@ -2,6 +2,7 @@ package envelopemw
 
 import (
 	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate-rest/envelope"
 	"github.com/happyhippyhippo/slate/env"
 )
 
@@ -24,6 +25,55 @@ var (
 	// path where the endpoint identification number can be retrieved.
 	EndpointIDConfigPathFormat = env.String(EnvID+"_ENDPOINT_ID_CONFIG_PATH_FORMAT", "slate.rest.endpoints.%s.id")
 
+	// EndpointConfigPathFormat defines the format of the configuration
+	// path of the endpoint configuration block. Besides the endpoint
+	// identification number, the block can define the endpoint accepted
+	// formats list (accept), the success response enveloping flag
+	// (envelope), the default success response status (status) and the
+	// problem details rendering flag (problem).
+	EndpointConfigPathFormat = env.String(EnvID+"_ENDPOINT_CONFIG_PATH_FORMAT", "slate.rest.endpoints.%s")
+
+	// AbsoluteLinks flag that defines if the response report links should
+	// be resolved into absolute URLs based on the incoming request.
+	AbsoluteLinks = env.Bool(EnvID+"_ABSOLUTE_LINKS", false)
+
+	// LinkHeader flag that defines if the response report links should
+	// also be emitted as RFC 8288 Link headers.
+	LinkHeader = env.Bool(EnvID+"_LINK_HEADER", false)
+
+	// TrustedProxies defines the comma separated list of IP addresses and
+	// CIDR ranges of the reverse proxies whose X-Forwarded-* headers are
+	// honoured when resolving the absolute links. The forwarded headers
+	// sent by any other remote address are ignored.
+	TrustedProxies = env.String(EnvID+"_TRUSTED_PROXIES", "")
+
+	// ProblemDetails flag that defines if all the error envelopes should
+	// be rendered as RFC 7807 problem details, even if not explicitly
+	// requested by the client.
+	ProblemDetails = env.Bool(EnvID+"_PROBLEM_DETAILS", false)
+
+	// ProblemType defines the type URI reference assigned to the
+	// rendered RFC 7807 problem details.
+	ProblemType = env.String(EnvID+"_PROBLEM_TYPE", envelope.ProblemBlankType)
+
+	// FieldsQueryParam defines the name of the query parameter used to
+	// request a sparse fieldset of the response data. Type scoped fieldsets
+	// are requested with the "<name>[<type>]" parameter format.
+	FieldsQueryParam = env.String(EnvID+"_FIELDS_QUERY_PARAM", "fields")
+
+	// FieldsStrict flag that defines if the request of an unknown response
+	// data field should result in a bad request error envelope.
+	FieldsStrict = env.Bool(EnvID+"_FIELDS_STRICT", false)
+
+	// StreamFlushCount defines the number of streamed records written
+	// between the flushes of the response writer.
+	StreamFlushCount = env.Int(EnvID+"_STREAM_FLUSH_COUNT", 100)
+
+	// ExposeErrors flag that defines if the raw description of the errors
+	// caught by the middleware should be exposed in the response. The raw
+	// error is never exposed when gin is running in release mode.
+	ExposeErrors = env.Bool(EnvID+"_EXPOSE_ERRORS", false)
+
 	// LogLevel @todo doc
 	LogLevel = env.String(EnvID+"_LOG_LEVEL", "error")
 
@@ -38,4 +88,8 @@ var (
 
 	// LogEndpointErrorMessage @todo doc
 	LogEndpointErrorMessage = env.String(EnvID+"_LOG_ENDPOINT_ERROR_MESSAGE", "Invalid endpoint id")
+
+	// LogPanicMessage defines the logging message of a panic recovered
+	// by the envelope middleware.
+	LogPanicMessage = env.String(EnvID+"_LOG_PANIC_MESSAGE", "Recovered endpoint panic")
 )
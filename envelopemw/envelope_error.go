@@ -0,0 +1,69 @@
+package envelopemw
+
+import (
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// IEnvelopeError defines the interface of an application error that
+// declares the HTTP status and the status error that should be used
+// when the error is converted into a response envelope.
+type IEnvelopeError interface {
+	error
+	StatusCode() int
+	StatusError() *envelope.StatusError
+}
+
+// EnvelopeError defines a typed application error that will be
+// converted into an envelope with the defined HTTP status, error code
+// and sanitized message.
+type EnvelopeError struct {
+	Status  int
+	Code    interface{}
+	Message string
+	Err     error
+}
+
+var _ IEnvelopeError = &EnvelopeError{}
+
+// NewEnvelopeError instantiates a new typed application error, optionally
+// wrapping the internal error that originated it.
+func NewEnvelopeError(
+	status int,
+	code interface{},
+	msg string,
+	err ...error,
+) *EnvelopeError {
+	e := &EnvelopeError{
+		Status:  status,
+		Code:    code,
+		Message: msg,
+	}
+	if len(err) != 0 {
+		e.Err = err[0]
+	}
+	return e
+}
+
+// Error retrieves the error description, giving priority to the
+// wrapped internal error.
+func (e *EnvelopeError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap retrieves the wrapped internal error.
+func (e *EnvelopeError) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode retrieves the HTTP status of the error.
+func (e *EnvelopeError) StatusCode() int {
+	return e.Status
+}
+
+// StatusError will compose the envelope status error of the error.
+func (e *EnvelopeError) StatusError() *envelope.StatusError {
+	return envelope.NewStatusError(e.Code, e.Message)
+}
@@ -0,0 +1,80 @@
+package envelopemw
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func Test_NewEnvelopeError(t *testing.T) {
+	t.Run("construct without wrapped error", func(t *testing.T) {
+		sut := NewEnvelopeError(http.StatusNotFound, 12, "not found")
+		switch {
+		case sut.Status != http.StatusNotFound:
+			t.Errorf("stored the (%v) status", sut.Status)
+		case sut.Code != 12:
+			t.Errorf("stored the (%v) code", sut.Code)
+		case sut.Message != "not found":
+			t.Errorf("stored the (%v) message", sut.Message)
+		case sut.Err != nil:
+			t.Errorf("stored the (%v) wrapped error", sut.Err)
+		}
+	})
+
+	t.Run("construct with wrapped error", func(t *testing.T) {
+		err := fmt.Errorf("error message")
+		sut := NewEnvelopeError(http.StatusNotFound, 12, "not found", err)
+
+		if check := sut.Err; check != err {
+			t.Errorf("stored the (%v) wrapped error", check)
+		}
+	})
+}
+
+func Test_EnvelopeError_Error(t *testing.T) {
+	t.Run("message without wrapped error", func(t *testing.T) {
+		if check := NewEnvelopeError(http.StatusNotFound, 12, "not found").Error(); check != "not found" {
+			t.Errorf("returned the (%v) description", check)
+		}
+	})
+
+	t.Run("wrapped error description", func(t *testing.T) {
+		sut := NewEnvelopeError(http.StatusNotFound, 12, "not found", fmt.Errorf("error message"))
+
+		if check := sut.Error(); check != "error message" {
+			t.Errorf("returned the (%v) description", check)
+		}
+	})
+}
+
+func Test_EnvelopeError_Unwrap(t *testing.T) {
+	t.Run("unwrap the wrapped error", func(t *testing.T) {
+		err := fmt.Errorf("error message")
+		sut := NewEnvelopeError(http.StatusNotFound, 12, "not found", err)
+
+		if !errors.Is(sut, err) {
+			t.Error("didn't unwrapped the wrapped error")
+		}
+	})
+}
+
+func Test_EnvelopeError_StatusCode(t *testing.T) {
+	t.Run("retrieve the status", func(t *testing.T) {
+		if check := NewEnvelopeError(http.StatusConflict, 12, "conflict").StatusCode(); check != http.StatusConflict {
+			t.Errorf("returned the (%v) status", check)
+		}
+	})
+}
+
+func Test_EnvelopeError_StatusError(t *testing.T) {
+	t.Run("compose the status error", func(t *testing.T) {
+		se := NewEnvelopeError(http.StatusConflict, 12, "conflict").StatusError()
+		switch {
+		case se.Code != "c:12":
+			t.Errorf("composed the (%v) code", se.Code)
+		case se.Message != "conflict":
+			t.Errorf("composed the (%v) message", se.Message)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package envelopemw
+
+import (
+	"errors"
+
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// ErrorMapping defines the envelope information that should be used
+// when converting an error that matches the mapping sentinel error.
+type ErrorMapping struct {
+	Error   error
+	Status  int
+	Code    interface{}
+	Message string
+}
+
+// IErrorMapper defines the interface of an error mapper instance.
+type IErrorMapper interface {
+	Register(mapping ErrorMapping) error
+	Map(err error) *envelope.Envelope
+}
+
+// ErrorMapper defines an instance used to convert application errors
+// into response envelopes based on the IEnvelopeError interface or on
+// registered sentinel error mappings.
+type ErrorMapper struct {
+	mappings []ErrorMapping
+}
+
+var _ IErrorMapper = &ErrorMapper{}
+
+// NewErrorMapper will instantiate a new error mapper without
+// any registered sentinel error mapping.
+func NewErrorMapper() IErrorMapper {
+	return &ErrorMapper{
+		mappings: []ErrorMapping{},
+	}
+}
+
+// Register will add a new sentinel error mapping to the mapper. The
+// mappings are checked in the order of their registration.
+func (m *ErrorMapper) Register(
+	mapping ErrorMapping,
+) error {
+	// check the mapping sentinel error reference
+	if mapping.Error == nil {
+		return errNilPointer("mapping.Error")
+	}
+	m.mappings = append(m.mappings, mapping)
+	return nil
+}
+
+// Map will convert the given error into a response envelope. A nil
+// envelope is returned if the error is not mapped, including the typed
+// errors that don't define a status error.
+func (m ErrorMapper) Map(
+	err error,
+) *envelope.Envelope {
+	// check the error argument reference
+	if err == nil {
+		return nil
+	}
+	// check if the error declares its own envelope information,
+	// ignoring the typed errors without a status error so they fall
+	// back to the sentinel and generic error handling
+	var typed IEnvelopeError
+	if errors.As(err, &typed) {
+		if se := typed.StatusError(); se != nil {
+			return envelope.NewEnvelope(typed.StatusCode(), nil).AddError(se)
+		}
+	}
+	// search for a matching sentinel error mapping
+	for _, mapping := range m.mappings {
+		if errors.Is(err, mapping.Error) {
+			return envelope.NewEnvelope(mapping.Status, nil).
+				AddError(envelope.NewStatusError(mapping.Code, mapping.Message))
+		}
+	}
+	return nil
+}
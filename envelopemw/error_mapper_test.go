@@ -0,0 +1,105 @@
+package envelopemw
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+type errorMapperTestNilStatus struct{}
+
+func (errorMapperTestNilStatus) Error() string { return "error message" }
+
+func (errorMapperTestNilStatus) StatusCode() int { return http.StatusConflict }
+
+func (errorMapperTestNilStatus) StatusError() *envelope.StatusError { return nil }
+
+func Test_ErrorMapper_Register(t *testing.T) {
+	t.Run("nil sentinel error", func(t *testing.T) {
+		if e := NewErrorMapper().Register(ErrorMapping{}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("register mapping", func(t *testing.T) {
+		sentinel := fmt.Errorf("error message")
+		sut := NewErrorMapper()
+
+		if e := sut.Register(ErrorMapping{Error: sentinel, Status: http.StatusConflict}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if check := sut.Map(sentinel); check == nil {
+			t.Error("didn't registered the mapping")
+		}
+	})
+}
+
+func Test_ErrorMapper_Map(t *testing.T) {
+	sentinel1 := fmt.Errorf("error message 1")
+	sentinel2 := fmt.Errorf("error message 2")
+	sut := NewErrorMapper()
+	_ = sut.Register(ErrorMapping{Error: sentinel1, Status: http.StatusNotFound, Code: 1, Message: "not found"})
+	_ = sut.Register(ErrorMapping{Error: sentinel2, Status: http.StatusConflict, Code: 2, Message: "conflict"})
+	_ = sut.Register(ErrorMapping{Error: sentinel2, Status: http.StatusGone, Code: 3, Message: "gone"})
+
+	t.Run("nil error", func(t *testing.T) {
+		if check := sut.Map(nil); check != nil {
+			t.Errorf("returned the unexpected (%v) envelope", check)
+		}
+	})
+
+	t.Run("unmapped error", func(t *testing.T) {
+		if check := sut.Map(fmt.Errorf("error message")); check != nil {
+			t.Errorf("returned the unexpected (%v) envelope", check)
+		}
+	})
+
+	t.Run("typed error", func(t *testing.T) {
+		err := fmt.Errorf("wrapped : %w", NewEnvelopeError(http.StatusUnprocessableEntity, 4, "invalid", sentinel1))
+
+		check := sut.Map(err)
+		switch {
+		case check == nil:
+			t.Error("didn't returned the expected envelope")
+		case check.GetStatusCode() != http.StatusUnprocessableEntity:
+			t.Errorf("returned the (%v) status code", check.GetStatusCode())
+		case check.Status.Errors[0].Code != "c:4":
+			t.Errorf("returned the (%v) error code", check.Status.Errors[0].Code)
+		case check.Status.Errors[0].Message != "invalid":
+			t.Errorf("returned the (%v) error message", check.Status.Errors[0].Message)
+		}
+	})
+
+	t.Run("typed error without status error", func(t *testing.T) {
+		if check := sut.Map(errorMapperTestNilStatus{}); check != nil {
+			t.Errorf("returned the unexpected (%v) envelope", check)
+		}
+	})
+
+	t.Run("sentinel error", func(t *testing.T) {
+		check := sut.Map(fmt.Errorf("wrapped : %w", sentinel1))
+		switch {
+		case check == nil:
+			t.Error("didn't returned the expected envelope")
+		case check.GetStatusCode() != http.StatusNotFound:
+			t.Errorf("returned the (%v) status code", check.GetStatusCode())
+		case check.Status.Errors[0].Code != "c:1":
+			t.Errorf("returned the (%v) error code", check.Status.Errors[0].Code)
+		case check.Status.Errors[0].Message != "not found":
+			t.Errorf("returned the (%v) error message", check.Status.Errors[0].Message)
+		}
+	})
+
+	t.Run("first registered sentinel error mapping", func(t *testing.T) {
+		if check := sut.Map(sentinel2); check == nil {
+			t.Error("didn't returned the expected envelope")
+		} else if check.GetStatusCode() != http.StatusConflict {
+			t.Errorf("returned the (%v) status code", check.GetStatusCode())
+		}
+	})
+}
@@ -6,6 +6,28 @@ import (
 	"github.com/happyhippyhippo/slate"
 )
 
+var (
+	// ErrRendererNotFound defines an error that signal that there is
+	// no registered renderer for the requested mime type.
+	ErrRendererNotFound = fmt.Errorf("renderer not found")
+
+	// ErrEndpointNotFound defines an error that signal that there is
+	// no generated middleware for the requested endpoint.
+	ErrEndpointNotFound = fmt.Errorf("endpoint not found")
+
+	// ErrInvalidAcceptList defines an error that signal that an endpoint
+	// accepted format list is not a list of mime type strings.
+	ErrInvalidAcceptList = fmt.Errorf("invalid accept list")
+
+	// ErrInvalidStatus defines an error that signal that an endpoint
+	// default status is not a valid HTTP status code.
+	ErrInvalidStatus = fmt.Errorf("invalid status")
+
+	// ErrInvalidXMLName defines an error that signal that a response
+	// data field name can't be used as a xml element or attribute name.
+	ErrInvalidXMLName = fmt.Errorf("invalid xml name")
+)
+
 func errNilPointer(
 	arg string,
 	ctx ...map[string]interface{},
@@ -20,3 +42,38 @@ func errConversion(
 ) error {
 	return slate.NewErrorFrom(slate.ErrConversion, fmt.Sprintf("%v to %s", val, t), ctx...)
 }
+
+func errRendererNotFound(
+	mime string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrRendererNotFound, mime, ctx...)
+}
+
+func errEndpointNotFound(
+	endpoint string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrEndpointNotFound, endpoint, ctx...)
+}
+
+func errInvalidAcceptList(
+	list interface{},
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidAcceptList, fmt.Sprintf("%v", list), ctx...)
+}
+
+func errInvalidStatus(
+	status int,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidStatus, fmt.Sprintf("%d", status), ctx...)
+}
+
+func errInvalidXMLName(
+	name string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidXMLName, name, ctx...)
+}
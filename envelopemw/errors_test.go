@@ -68,3 +68,123 @@ func Test_errConversion(t *testing.T) {
 		}
 	})
 }
+
+func Test_errRendererNotFound(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : renderer not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errRendererNotFound(arg); !errors.Is(e, ErrRendererNotFound) {
+			t.Errorf("error not a instance of ErrRendererNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errRendererNotFound(arg, context); !errors.Is(e, ErrRendererNotFound) {
+			t.Errorf("error not a instance of ErrRendererNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errEndpointNotFound(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : endpoint not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errEndpointNotFound(arg); !errors.Is(e, ErrEndpointNotFound) {
+			t.Errorf("error not a instance of ErrEndpointNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errEndpointNotFound(arg, context); !errors.Is(e, ErrEndpointNotFound) {
+			t.Errorf("error not a instance of ErrEndpointNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errInvalidStatus(t *testing.T) {
+	arg := 600
+	context := map[string]interface{}{"field": "value"}
+	message := "600 : invalid status"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidStatus(arg); !errors.Is(e, ErrInvalidStatus) {
+			t.Errorf("error not a instance of ErrInvalidStatus")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidStatus(arg, context); !errors.Is(e, ErrInvalidStatus) {
+			t.Errorf("error not a instance of ErrInvalidStatus")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errInvalidXMLName(t *testing.T) {
+	arg := "1x"
+	context := map[string]interface{}{"field": "value"}
+	message := "1x : invalid xml name"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidXMLName(arg); !errors.Is(e, ErrInvalidXMLName) {
+			t.Errorf("error not a instance of ErrInvalidXMLName")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidXMLName(arg, context); !errors.Is(e, ErrInvalidXMLName) {
+			t.Errorf("error not a instance of ErrInvalidXMLName")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
@@ -0,0 +1,707 @@
+package envelopemw
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// IResourceType defines the interface of a response data structure that
+// declares its resource type used to select a type scoped fieldset.
+type IResourceType interface {
+	ResourceType() string
+}
+
+// fieldTree defines a set of selected field paths, where a nil
+// subtree defines that the full field value is selected.
+type fieldTree map[string]fieldTree
+
+func (t fieldTree) add(
+	path string,
+) {
+	node := t
+	for _, name := range strings.Split(path, ".") {
+		child, ok := node[name]
+		if !ok || child == nil {
+			child = fieldTree{}
+			node[name] = child
+		}
+		node = child
+	}
+}
+
+func (t fieldTree) leafs() fieldTree {
+	// convert the empty subtrees into full selection nodes
+	for name, child := range t {
+		if len(child) == 0 {
+			t[name] = nil
+		} else {
+			child.leafs()
+		}
+	}
+	return t
+}
+
+// prunedField defines a selected field of a pruned data object.
+type prunedField struct {
+	name   string
+	quoted bool
+	xml    xmlField
+	value  interface{}
+}
+
+// jsonField defines the json serialization options of a structure field.
+type jsonField struct {
+	name      string
+	omitEmpty bool
+	quoted    bool
+}
+
+// structField defines a serializable field of a data structure, where
+// the embedded structures fields are collected with their depth so the
+// dominant field of a name can be resolved.
+type structField struct {
+	name   string
+	tagged bool
+	depth  int
+	json   jsonField
+	field  reflect.StructField
+	value  reflect.Value
+}
+
+// xmlField defines the xml serialization options of a pruned field.
+type xmlField struct {
+	name     string
+	skip     bool
+	attr     bool
+	chardata bool
+}
+
+// prunedObject defines a data object containing only the selected
+// fields, presented in the order of their definition, and the xml
+// element name defined by the data structure XMLName field.
+type prunedObject struct {
+	name   *xml.Name
+	fields []prunedField
+}
+
+// MarshalJSON serialize the pruned object into a json object.
+func (o prunedObject) MarshalJSON() ([]byte, error) {
+	b := bytes.Buffer{}
+	b.WriteString("{")
+	for i, f := range o.fields {
+		if i != 0 {
+			b.WriteString(",")
+		}
+		name, _ := json.Marshal(f.name)
+		value, e := json.Marshal(f.value)
+		if e != nil {
+			return nil, e
+		}
+		// quote the scalar values of the fields tagged with the string option
+		if f.quoted && string(value) != "null" {
+			value, _ = json.Marshal(string(value))
+		}
+		b.Write(name)
+		b.WriteString(":")
+		b.Write(value)
+	}
+	b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// MarshalXML serialize the pruned object into a xml element.
+func (o prunedObject) MarshalXML(
+	e *xml.Encoder,
+	start xml.StartElement,
+) error {
+	// the data structure XMLName field names the element
+	if o.name != nil {
+		start.Name = *o.name
+	}
+	if !isXMLName(start.Name.Local) {
+		return errInvalidXMLName(start.Name.Local)
+	}
+	// assign the attribute fields to the element start
+	start.Attr = append([]xml.Attr{}, start.Attr...)
+	for _, f := range o.fields {
+		if f.xml.skip || !f.xml.attr {
+			continue
+		}
+		if !isXMLName(f.xml.name) {
+			return errInvalidXMLName(f.xml.name)
+		}
+		text, ok, err := xmlText(f.value)
+		if err != nil {
+			return err
+		}
+		if ok {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: f.xml.name}, Value: text})
+		}
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	// encode the character data and element fields
+	for _, f := range o.fields {
+		switch {
+		case f.xml.skip, f.xml.attr:
+		case f.xml.chardata:
+			text, _, err := xmlText(f.value)
+			if err != nil {
+				return err
+			}
+			if err := e.EncodeToken(xml.CharData(text)); err != nil {
+				return err
+			}
+		default:
+			if !isXMLName(f.xml.name) {
+				return errInvalidXMLName(f.xml.name)
+			}
+			// a not pruned structure is still named by its XMLName field
+			child := xml.StartElement{Name: xml.Name{Local: f.xml.name}}
+			if name := xmlElementName(reflect.ValueOf(f.value)); name != nil {
+				child.Name = *name
+			}
+			if err := e.EncodeElement(f.value, child); err != nil {
+				return err
+			}
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// fieldPruner defines the instance used to prune a response data
+// structure to the requested sparse fieldsets.
+type fieldPruner struct {
+	types   map[string]fieldTree
+	unknown map[string]bool
+}
+
+// selectFields will prune the response data to the sparse fieldsets
+// requested in the request query. If strict mode is enabled, an error
+// envelope listing the unknown requested fields is returned.
+func selectFields(
+	ctx *gin.Context,
+	response *envelope.Envelope,
+) *envelope.Envelope {
+	// only successful envelopes with data can be pruned
+	if response.Data == nil || response.Status == nil || !response.Status.Success {
+		return response
+	}
+	// parse the requested fieldsets
+	if ctx.Request == nil || ctx.Request.URL == nil {
+		return response
+	}
+	fields, types := parseFields(ctx.Request.URL.Query())
+	if fields == nil && len(types) == 0 {
+		return response
+	}
+	// prune the response data
+	p := &fieldPruner{types: types, unknown: map[string]bool{}}
+	data := p.prune(reflect.ValueOf(response.Data), fields, "")
+	// check for unknown requested fields
+	if FieldsStrict && len(p.unknown) != 0 {
+		var paths []string
+		for path := range p.unknown {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		env := envelope.NewEnvelope(http.StatusBadRequest, nil)
+		for _, path := range paths {
+			env.AddError(envelope.NewStatusError(0, "unknown field").SetField(path))
+		}
+		return env
+	}
+	response.Data = data
+	return response
+}
+
+func parseFields(
+	query map[string][]string,
+) (fieldTree, map[string]fieldTree) {
+	var fields fieldTree
+	types := map[string]fieldTree{}
+	for key, values := range query {
+		// select the tree of the iterated query parameter
+		var tree fieldTree
+		switch {
+		case key == FieldsQueryParam:
+			if fields == nil {
+				fields = fieldTree{}
+			}
+			tree = fields
+		case strings.HasPrefix(key, FieldsQueryParam+"[") && strings.HasSuffix(key, "]"):
+			name := key[len(FieldsQueryParam)+1 : len(key)-1]
+			tree = fieldTree{}
+			types[name] = tree
+		default:
+			continue
+		}
+		// add all the comma separated paths to the tree
+		for _, value := range values {
+			for _, path := range strings.Split(value, ",") {
+				if path = strings.TrimSpace(path); path != "" {
+					tree.add(path)
+				}
+			}
+		}
+		tree.leafs()
+	}
+	// discard empty selections
+	if len(fields) == 0 {
+		fields = nil
+	}
+	for name, tree := range types {
+		if len(tree) == 0 {
+			delete(types, name)
+		}
+	}
+	return fields, types
+}
+
+func (p *fieldPruner) prune(
+	v reflect.Value,
+	tree fieldTree,
+	path string,
+) interface{} {
+	// values without any selection to be applied are not pruned
+	if tree == nil && len(p.types) == 0 {
+		if !v.IsValid() {
+			return nil
+		}
+		return v.Interface()
+	}
+	// dereference pointers and interfaces
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	// values with custom serialization are not pruned
+	if isMarshaler(v) {
+		p.reject(tree, path)
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		return p.pruneStruct(v, p.selection(v, tree), path)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		return p.pruneMap(v, tree, path)
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list[i] = p.prune(v.Index(i), tree, path)
+		}
+		return list
+	}
+	// scalar values can't have selected sub fields
+	p.reject(tree, path)
+	return v.Interface()
+}
+
+func (p *fieldPruner) pruneStruct(
+	v reflect.Value,
+	tree fieldTree,
+	path string,
+) interface{} {
+	obj := prunedObject{name: xmlElementName(v)}
+	found := map[string]bool{}
+	for _, f := range dominantFields(structFields(v.Type(), v, 0)) {
+		// fields of nil embedded structures have no value to be presented
+		if !f.value.IsValid() {
+			continue
+		}
+		// check if the field is selected
+		child, selected := tree[f.name]
+		if tree != nil && !selected {
+			continue
+		}
+		found[f.name] = true
+		if f.json.omitEmpty && isEmpty(f.value) {
+			continue
+		}
+		x, xmlOmitEmpty := xmlTag(f.field)
+		if xmlOmitEmpty && isEmpty(f.value) {
+			x.skip = true
+		}
+		// the XMLName field names the element instead of being a child
+		if f.field.Name == "XMLName" {
+			x.skip = true
+		}
+		obj.fields = append(obj.fields, prunedField{
+			name:   f.name,
+			quoted: f.json.quoted,
+			xml:    x,
+			value:  p.prune(f.value, child, join(path, f.name)),
+		})
+	}
+	p.checkUnknown(tree, found, path)
+	return obj
+}
+
+func structFields(
+	t reflect.Type,
+	v reflect.Value,
+	depth int,
+) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		// parse the field serialization tags
+		tag, skip := jsonTag(sf)
+		if skip {
+			continue
+		}
+		var fv reflect.Value
+		if v.IsValid() {
+			fv = v.Field(i)
+		}
+		// collect the embedded structures fields, even of the nil
+		// pointers, so they take part on the dominance resolution
+		if sf.Anonymous && tag.name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if fv.IsValid() && fv.Kind() == reflect.Pointer {
+					if fv.IsNil() {
+						fv = reflect.Value{}
+					} else {
+						fv = fv.Elem()
+					}
+				}
+				fields = append(fields, structFields(ft, fv, depth+1)...)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		name := tag.name
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, structField{
+			name:   name,
+			tagged: tag.name != "",
+			depth:  depth,
+			json:   tag,
+			field:  sf,
+			value:  fv,
+		})
+	}
+	return fields
+}
+
+func dominantFields(
+	fields []structField,
+) []structField {
+	// discover the shallowest depth of every field name
+	depth := map[string]int{}
+	for _, f := range fields {
+		if d, ok := depth[f.name]; !ok || f.depth < d {
+			depth[f.name] = f.depth
+		}
+	}
+	// count the fields, and the tagged fields, at the shallowest depth
+	count := map[string]int{}
+	tagged := map[string]int{}
+	for _, f := range fields {
+		if f.depth == depth[f.name] {
+			count[f.name]++
+			if f.tagged {
+				tagged[f.name]++
+			}
+		}
+	}
+	// keep the dominant fields and discard the ambiguous ones
+	var dominant []structField
+	for _, f := range fields {
+		switch {
+		case f.depth != depth[f.name]:
+		case count[f.name] == 1, f.tagged && tagged[f.name] == 1:
+			dominant = append(dominant, f)
+		}
+	}
+	return dominant
+}
+
+func (p *fieldPruner) pruneMap(
+	v reflect.Value,
+	tree fieldTree,
+	path string,
+) interface{} {
+	// sort the map keys for a deterministic output
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	obj := prunedObject{}
+	found := map[string]bool{}
+	for _, key := range keys {
+		name := key.String()
+		child, selected := tree[name]
+		if tree != nil && !selected {
+			continue
+		}
+		found[name] = true
+		obj.fields = append(obj.fields, prunedField{
+			name:  name,
+			xml:   xmlField{name: name},
+			value: p.prune(v.MapIndex(key), child, join(path, name)),
+		})
+	}
+	p.checkUnknown(tree, found, path)
+	return obj
+}
+
+func (p *fieldPruner) selection(
+	v reflect.Value,
+	tree fieldTree,
+) fieldTree {
+	// a path selection have priority over a type scoped fieldset
+	if tree != nil || len(p.types) == 0 {
+		return tree
+	}
+	// discover the resource type of the structure
+	name := strings.ToLower(v.Type().Name())
+	if v.CanInterface() {
+		if rt, ok := v.Interface().(IResourceType); ok {
+			name = rt.ResourceType()
+		}
+	}
+	return p.types[name]
+}
+
+func (p *fieldPruner) checkUnknown(
+	tree fieldTree,
+	found map[string]bool,
+	path string,
+) {
+	for name := range tree {
+		if !found[name] {
+			p.unknown[join(path, name)] = true
+		}
+	}
+}
+
+func (p *fieldPruner) reject(
+	tree fieldTree,
+	path string,
+) {
+	p.checkUnknown(tree, map[string]bool{}, path)
+}
+
+func isMarshaler(
+	v reflect.Value,
+) bool {
+	if !v.CanInterface() {
+		return false
+	}
+	switch v.Interface().(type) {
+	case json.Marshaler, xml.Marshaler, encoding.TextMarshaler:
+		return true
+	}
+	if v.CanAddr() {
+		switch v.Addr().Interface().(type) {
+		case json.Marshaler, xml.Marshaler, encoding.TextMarshaler:
+			return true
+		}
+	}
+	return false
+}
+
+func isEmpty(
+	v reflect.Value,
+) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+func jsonTag(
+	sf reflect.StructField,
+) (jsonField, bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return jsonField{}, true
+	}
+	parts := strings.Split(tag, ",")
+	f := jsonField{name: parts[0]}
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "omitempty":
+			f.omitEmpty = true
+		case "string":
+			// only the scalar values can be quoted
+			ft := sf.Type
+			if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			switch ft.Kind() {
+			case reflect.Bool,
+				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+				reflect.Float32, reflect.Float64,
+				reflect.String:
+				f.quoted = true
+			}
+		}
+	}
+	return f, false
+}
+
+func xmlTag(
+	sf reflect.StructField,
+) (xmlField, bool) {
+	tag := sf.Tag.Get("xml")
+	if tag == "-" {
+		return xmlField{skip: true}, false
+	}
+	parts := strings.Split(tag, ",")
+	f := xmlField{name: parts[0]}
+	if f.name == "" {
+		f.name = sf.Name
+	}
+	omitEmpty := false
+	for _, opt := range parts[1:] {
+		switch opt {
+		case "attr":
+			f.attr = true
+		case "chardata":
+			f.chardata = true
+		case "omitempty":
+			omitEmpty = true
+		}
+	}
+	return f, omitEmpty
+}
+
+func xmlElementName(
+	v reflect.Value,
+) *xml.Name {
+	// dereference pointers and interfaces
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return nil
+	}
+	sf, ok := v.Type().FieldByName("XMLName")
+	if !ok {
+		return nil
+	}
+	// the XMLName field tag have priority over the field value
+	if tag := strings.Split(sf.Tag.Get("xml"), ",")[0]; tag != "" && tag != "-" {
+		name := xml.Name{Local: tag}
+		if i := strings.LastIndex(tag, " "); i >= 0 {
+			name.Space, name.Local = tag[:i], tag[i+1:]
+		}
+		if name.Local != "" {
+			return &name
+		}
+	}
+	fv, e := v.FieldByIndexErr(sf.Index)
+	if e != nil || !fv.CanInterface() {
+		return nil
+	}
+	if name, ok := fv.Interface().(xml.Name); ok && name.Local != "" {
+		return &name
+	}
+	return nil
+}
+
+func isXMLName(
+	name string,
+) bool {
+	// the name must start with a letter or underscore, followed by
+	// letters, digits, underscores, hyphens, periods or colons
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || r == '.' || r == ':' || unicode.IsDigit(r)):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
+func xmlText(
+	value interface{},
+) (string, bool, error) {
+	// text marshalers are serialized by their own text representation
+	if m, ok := value.(encoding.TextMarshaler); ok {
+		b, e := m.MarshalText()
+		return string(b), true, e
+	}
+	// dereference pointers and interfaces, where nil values have no text
+	v := reflect.ValueOf(value)
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", false, nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, e := m.MarshalText()
+		return string(b), true, e
+	}
+	// only scalar values and byte slices can be serialized as text
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), true, nil
+		}
+		return "", false, &xml.UnsupportedTypeError{Type: v.Type()}
+	case reflect.Struct, reflect.Map, reflect.Array, reflect.Chan, reflect.Func:
+		return "", false, &xml.UnsupportedTypeError{Type: v.Type()}
+	}
+	return fmt.Sprint(v.Interface()), true, nil
+}
+
+func join(
+	path,
+	name string,
+) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
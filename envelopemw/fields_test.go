@@ -0,0 +1,512 @@
+package envelopemw
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+type fieldsTestAddress struct {
+	Street string `json:"street" xml:"street"`
+	City   string `json:"city" xml:"city"`
+}
+
+type fieldsTestAudit struct {
+	Created time.Time `json:"created" xml:"created"`
+}
+
+type fieldsTestUser struct {
+	fieldsTestAudit
+	ID       int                `json:"id" xml:"id"`
+	Name     string             `json:"name" xml:"name"`
+	Password string             `json:"-" xml:"-"`
+	Nick     string             `json:"nick,omitempty" xml:"nick,omitempty"`
+	Address  *fieldsTestAddress `json:"address" xml:"address"`
+	Tags     map[string]string  `json:"tags" xml:"-"`
+	internal string
+}
+
+type fieldsTestPost struct {
+	Title  string          `json:"title" xml:"title"`
+	Body   string          `json:"body" xml:"body"`
+	Author *fieldsTestUser `json:"author" xml:"author"`
+}
+
+type fieldsTestLabel struct {
+	ID     int      `json:"id" xml:"id,attr"`
+	Ref    *string  `json:"ref" xml:"ref,attr"`
+	Text   string   `json:"text" xml:",chardata"`
+	Secret string   `json:"secret" xml:"-"`
+	Note   string   `json:"note" xml:"note,omitempty"`
+	Scopes []string `json:"scopes" xml:"scopes,attr"`
+}
+
+type fieldsTestItem struct {
+	XMLName xml.Name `json:"-" xml:"item"`
+	ID      int      `json:"id" xml:"id,attr"`
+	Name    string   `json:"name" xml:"name"`
+}
+
+type fieldsTestNamed struct {
+	XMLName xml.Name
+	ID      int    `json:"id" xml:"id"`
+	Name    string `json:"name" xml:"name"`
+}
+
+type fieldsTestTagged struct {
+	Title string `json:"Title"`
+	Code  string `json:"code"`
+}
+
+type fieldsTestUntagged struct {
+	Title string
+	Code  string `json:"code"`
+	Note  string `json:"name"`
+}
+
+type fieldsTestDominance struct {
+	fieldsTestTagged
+	*fieldsTestUntagged
+	Name string `json:"name"`
+}
+
+type fieldsTestQuoted struct {
+	ID   int64    `json:"id,string"`
+	Rate *float64 `json:"rate,string"`
+	Nil  *int     `json:"nil,string"`
+	Name string   `json:"name,string"`
+	Flag bool     `json:"flag,string"`
+	Tags []string `json:"tags,string"`
+}
+
+func (fieldsTestPost) ResourceType() string {
+	return "articles"
+}
+
+func Test_parseFields(t *testing.T) {
+	scenarios := []struct {
+		query  map[string][]string
+		fields fieldTree
+		types  map[string]fieldTree
+	}{
+		{ // no fields parameter
+			query:  map[string][]string{"search": {"value"}},
+			fields: nil,
+			types:  map[string]fieldTree{},
+		},
+		{ // empty fields parameter
+			query:  map[string][]string{"fields": {""}, "fields[user]": {" , "}},
+			fields: nil,
+			types:  map[string]fieldTree{},
+		},
+		{ // simple fields
+			query:  map[string][]string{"fields": {"id, name"}},
+			fields: fieldTree{"id": nil, "name": nil},
+			types:  map[string]fieldTree{},
+		},
+		{ // nested fields over multiple parameters
+			query:  map[string][]string{"fields": {"id,address.city", "address.street"}},
+			fields: fieldTree{"id": nil, "address": fieldTree{"city": nil, "street": nil}},
+			types:  map[string]fieldTree{},
+		},
+		{ // full and nested selection of the same field
+			query:  map[string][]string{"fields": {"address,address.city"}},
+			fields: fieldTree{"address": fieldTree{"city": nil}},
+			types:  map[string]fieldTree{},
+		},
+		{ // type scoped fields
+			query:  map[string][]string{"fields[articles]": {"title"}, "fields[users]": {"name"}},
+			fields: nil,
+			types:  map[string]fieldTree{"articles": {"title": nil}, "users": {"name": nil}},
+		},
+	}
+
+	for _, s := range scenarios {
+		fields, types := parseFields(s.query)
+		if !reflect.DeepEqual(fields, s.fields) {
+			t.Errorf("parsed the (%v) fields when expecting (%v)", fields, s.fields)
+		} else if !reflect.DeepEqual(types, s.types) {
+			t.Errorf("parsed the (%v) type fields when expecting (%v)", types, s.types)
+		}
+	}
+}
+
+func Test_selectFields(t *testing.T) {
+	newContext := func(query string) *gin.Context {
+		gin.SetMode(gin.ReleaseMode)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource?"+query, nil)
+		return ctx
+	}
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	newUser := func() *fieldsTestUser {
+		return &fieldsTestUser{
+			fieldsTestAudit: fieldsTestAudit{Created: created},
+			ID:              1,
+			Name:            "name",
+			Password:        "secret",
+			Address:         &fieldsTestAddress{Street: "street", City: "city"},
+			Tags:            map[string]string{"b": "2", "a": "1"},
+			internal:        "internal",
+		}
+	}
+
+	scenarios := []struct {
+		name     string
+		query    string
+		data     interface{}
+		expected string
+	}{
+		{
+			name:     "no selection",
+			query:    "",
+			data:     newUser(),
+			expected: `{"created":"2020-01-02T03:04:05Z","id":1,"name":"name","address":{"street":"street","city":"city"},"tags":{"a":"1","b":"2"}}`,
+		},
+		{
+			name:     "top level fields",
+			query:    "fields=name,id",
+			data:     newUser(),
+			expected: `{"id":1,"name":"name"}`,
+		},
+		{
+			name:     "embedded structure fields",
+			query:    "fields=created",
+			data:     newUser(),
+			expected: `{"created":"2020-01-02T03:04:05Z"}`,
+		},
+		{
+			name:     "nested fields",
+			query:    "fields=id,address.city,tags.b",
+			data:     newUser(),
+			expected: `{"id":1,"address":{"city":"city"},"tags":{"b":"2"}}`,
+		},
+		{
+			name:     "full nested structure",
+			query:    "fields=address",
+			data:     newUser(),
+			expected: `{"address":{"street":"street","city":"city"}}`,
+		},
+		{
+			name:     "omit empty selected field",
+			query:    "fields=id,nick",
+			data:     newUser(),
+			expected: `{"id":1}`,
+		},
+		{
+			name:     "list of records",
+			query:    "fields=id",
+			data:     []*fieldsTestUser{newUser(), nil},
+			expected: `[{"id":1},null]`,
+		},
+		{
+			name:     "map records",
+			query:    "fields=id",
+			data:     []map[string]interface{}{{"id": 1, "name": "name"}},
+			expected: `[{"id":1}]`,
+		},
+		{
+			name:     "type scoped fields",
+			query:    "fields[articles]=title,author&fields[fieldstestuser]=name",
+			data:     []fieldsTestPost{{Title: "title", Body: "body", Author: newUser()}},
+			expected: `[{"title":"title","author":{"name":"name"}}]`,
+		},
+		{
+			name:     "path selection priority over type scoped fields",
+			query:    "fields=body&fields[articles]=title",
+			data:     fieldsTestPost{Title: "title", Body: "body"},
+			expected: `{"body":"body"}`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			response := selectFields(newContext(s.query), envelope.NewEnvelope(http.StatusOK, s.data))
+
+			if check, e := json.Marshal(response.Data); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if string(check) != s.expected {
+				t.Errorf("selected the (%v) data when expecting (%v)", string(check), s.expected)
+			}
+		})
+	}
+
+	t.Run("don't prune error envelopes", func(t *testing.T) {
+		response := envelope.NewEnvelope(http.StatusOK, newUser()).AddError(envelope.NewStatusError(1, "message"))
+
+		if check := selectFields(newContext("fields=id"), response); check.Data != response.Data {
+			t.Errorf("pruned the (%v) data", check.Data)
+		}
+	})
+
+	t.Run("don't prune without request url", func(t *testing.T) {
+		gin.SetMode(gin.ReleaseMode)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = &http.Request{}
+		response := envelope.NewEnvelope(http.StatusOK, newUser())
+
+		if check := selectFields(ctx, response); check.Data != response.Data {
+			t.Errorf("pruned the (%v) data", check.Data)
+		}
+	})
+
+	t.Run("ignore unknown fields if not strict", func(t *testing.T) {
+		response := selectFields(newContext("fields=id,unknown,name.first"), envelope.NewEnvelope(http.StatusOK, newUser()))
+		expected := `{"id":1,"name":"name"}`
+
+		if check, _ := json.Marshal(response.Data); string(check) != expected {
+			t.Errorf("selected the (%v) data when expecting (%v)", string(check), expected)
+		}
+	})
+
+	t.Run("error envelope on unknown fields if strict", func(t *testing.T) {
+		prev := FieldsStrict
+		FieldsStrict = true
+		defer func() { FieldsStrict = prev }()
+
+		response := selectFields(newContext("fields=id,unknown,name.first,created.year"), envelope.NewEnvelope(http.StatusOK, newUser()))
+		expected := `{"status":{"success":false,"error":[{"code":"c:0","message":"unknown field","field":"created.year"},{"code":"c:0","message":"unknown field","field":"name.first"},{"code":"c:0","message":"unknown field","field":"unknown"}]}}`
+
+		if check := response.GetStatusCode(); check != http.StatusBadRequest {
+			t.Errorf("returned the (%v) status code", check)
+		} else if check, _ := json.Marshal(response); string(check) != expected {
+			t.Errorf("returned the (%v) envelope when expecting (%v)", string(check), expected)
+		}
+	})
+
+	t.Run("xml serialization", func(t *testing.T) {
+		response := selectFields(newContext("fields=id,address.city"), envelope.NewEnvelope(http.StatusOK, newUser()))
+		expected := `<envelope><status><success>true</success><error></error></status><data><id>1</id><address><city>city</city></address></data></envelope>`
+
+		if check, e := xml.Marshal(response); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expected {
+			t.Errorf("serialized the (%v) envelope when expecting (%v)", string(check), expected)
+		}
+	})
+	t.Run("xml serialization skip the xml hidden fields", func(t *testing.T) {
+		response := selectFields(newContext("fields=id,tags"), envelope.NewEnvelope(http.StatusOK, newUser()))
+		expected := `<envelope><status><success>true</success><error></error></status><data><id>1</id></data></envelope>`
+
+		if check, e := xml.Marshal(response); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != expected {
+			t.Errorf("serialized the (%v) envelope when expecting (%v)", string(check), expected)
+		}
+	})
+
+	t.Run("xml serialization keep the attribute and character data fields", func(t *testing.T) {
+		ref := "ref"
+		scenarios := []struct {
+			test     string
+			data     fieldsTestLabel
+			expected string
+		}{
+			{ // nil attribute
+				test:     "nil attribute",
+				data:     fieldsTestLabel{ID: 1, Text: "text", Secret: "secret"},
+				expected: `<envelope><status><success>true</success><error></error></status><data id="1">text</data></envelope>`,
+			},
+			{ // all fields
+				test:     "all fields",
+				data:     fieldsTestLabel{ID: 1, Ref: &ref, Text: "text", Secret: "secret", Note: "note"},
+				expected: `<envelope><status><success>true</success><error></error></status><data id="1" ref="ref">text<note>note</note></data></envelope>`,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				response := selectFields(newContext("fields=id,ref,text,secret,note"), envelope.NewEnvelope(http.StatusOK, s.data))
+
+				if check, e := xml.Marshal(response); e != nil {
+					t.Errorf("returned the unexpected (%v) error", e)
+				} else if string(check) != s.expected {
+					t.Errorf("serialized the (%v) envelope when expecting (%v)", string(check), s.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("xml serialization of an unsupported attribute", func(t *testing.T) {
+		response := selectFields(newContext("fields=id,scopes"), envelope.NewEnvelope(http.StatusOK, fieldsTestLabel{ID: 1, Scopes: []string{"read"}}))
+
+		var check *xml.UnsupportedTypeError
+		if _, e := xml.Marshal(response); !errors.As(e, &check) {
+			t.Errorf("returned the (%v) error when expecting an unsupported type error", e)
+		}
+	})
+
+	t.Run("xml serialization named by the XMLName field", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			data     interface{}
+			expected string
+		}{
+			{ // tagged XMLName field
+				test:     "tagged XMLName field",
+				data:     fieldsTestItem{ID: 1, Name: "name"},
+				expected: `<envelope><status><success>true</success><error></error></status><item id="1"><name>name</name></item></envelope>`,
+			},
+			{ // XMLName field value
+				test:     "XMLName field value",
+				data:     fieldsTestNamed{XMLName: xml.Name{Local: "named"}, ID: 1, Name: "name"},
+				expected: `<envelope><status><success>true</success><error></error></status><named><id>1</id><name>name</name></named></envelope>`,
+			},
+			{ // XMLName field without value
+				test:     "XMLName field without value",
+				data:     fieldsTestNamed{ID: 1, Name: "name"},
+				expected: `<envelope><status><success>true</success><error></error></status><data><id>1</id><name>name</name></data></envelope>`,
+			},
+			{ // not pruned structure
+				test:     "not pruned structure",
+				data:     map[string]interface{}{"id": 1, "item": &fieldsTestItem{ID: 2, Name: "name"}},
+				expected: `<envelope><status><success>true</success><error></error></status><data><id>1</id><item id="2"><name>name</name></item></data></envelope>`,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				response := selectFields(newContext("fields=id,name,item,XMLName"), envelope.NewEnvelope(http.StatusOK, s.data))
+
+				if check, e := xml.Marshal(response); e != nil {
+					t.Errorf("returned the unexpected (%v) error", e)
+				} else if string(check) != s.expected {
+					t.Errorf("serialized the (%v) envelope when expecting (%v)", string(check), s.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("xml serialization of an invalid element name", func(t *testing.T) {
+		for _, name := range []string{"a b", "1x", "-x", "a<b"} {
+			query := url.Values{FieldsQueryParam: {name}}.Encode()
+			data := map[string]interface{}{name: 1}
+			response := selectFields(newContext(query), envelope.NewEnvelope(http.StatusOK, data))
+
+			if _, e := xml.Marshal(response); !errors.Is(e, ErrInvalidXMLName) {
+				t.Errorf("returned the (%v) error for the (%v) name when expecting (%v)", e, name, ErrInvalidXMLName)
+			}
+		}
+	})
+
+	t.Run("embedded fields dominance", func(t *testing.T) {
+		scenarios := []struct {
+			test string
+			data fieldsTestDominance
+		}{
+			{ // nil embedded pointer
+				test: "nil embedded pointer",
+				data: fieldsTestDominance{fieldsTestTagged: fieldsTestTagged{Title: "title", Code: "code"}, Name: "name"},
+			},
+			{ // embedded pointer
+				test: "embedded pointer",
+				data: fieldsTestDominance{
+					fieldsTestTagged:   fieldsTestTagged{Title: "title", Code: "code"},
+					fieldsTestUntagged: &fieldsTestUntagged{Title: "untagged", Code: "other", Note: "note"},
+					Name:               "name",
+				},
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				response := selectFields(newContext("fields=Title,code,name"), envelope.NewEnvelope(http.StatusOK, s.data))
+				expected, _ := json.Marshal(s.data)
+
+				if check, e := json.Marshal(response.Data); e != nil {
+					t.Errorf("returned the unexpected (%v) error", e)
+				} else if string(check) != string(expected) {
+					t.Errorf("selected the (%v) data when expecting (%v)", string(check), string(expected))
+				}
+			})
+		}
+	})
+
+	t.Run("ambiguous embedded fields are unknown", func(t *testing.T) {
+		prev := FieldsStrict
+		FieldsStrict = true
+		defer func() { FieldsStrict = prev }()
+
+		response := selectFields(newContext("fields=code"), envelope.NewEnvelope(http.StatusOK, fieldsTestDominance{}))
+
+		if check := response.GetStatusCode(); check != http.StatusBadRequest {
+			t.Errorf("returned the (%v) status code", check)
+		}
+	})
+
+	t.Run("quote the fields tagged with the string option", func(t *testing.T) {
+		rate := 1.5
+		data := fieldsTestQuoted{ID: 1, Rate: &rate, Name: `a "b" <c>`, Flag: true, Tags: []string{"a"}}
+		response := selectFields(newContext("fields=id,rate,nil,name,flag,tags"), envelope.NewEnvelope(http.StatusOK, data))
+		expected, _ := json.Marshal(data)
+
+		if check, e := json.Marshal(response.Data); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if string(check) != string(expected) {
+			t.Errorf("selected the (%v) data when expecting (%v)", string(check), string(expected))
+		}
+	})
+
+	t.Run("xml serialization round trip", func(t *testing.T) {
+		ref := "ref"
+
+		t.Run("user", func(t *testing.T) {
+			user := newUser()
+			response := selectFields(newContext("fields=created,id,name,nick,address"), envelope.NewEnvelope(http.StatusOK, user))
+			expected := &fieldsTestUser{fieldsTestAudit: user.fieldsTestAudit, ID: user.ID, Name: user.Name, Address: user.Address}
+
+			check := struct {
+				Data *fieldsTestUser `xml:"data"`
+			}{}
+			if data, e := xml.Marshal(response); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if e := xml.Unmarshal(data, &check); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check.Data, expected) {
+				t.Errorf("decoded the (%v) data when expecting (%v)", check.Data, expected)
+			}
+		})
+
+		t.Run("label", func(t *testing.T) {
+			label := fieldsTestLabel{ID: 1, Ref: &ref, Text: "text", Secret: "secret", Note: "note"}
+			response := selectFields(newContext("fields=id,ref,text,secret,note"), envelope.NewEnvelope(http.StatusOK, label))
+			expected := fieldsTestLabel{ID: 1, Ref: &ref, Text: "text", Note: "note"}
+
+			check := struct {
+				Data fieldsTestLabel `xml:"data"`
+			}{}
+			if data, e := xml.Marshal(response); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if e := xml.Unmarshal(data, &check); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check.Data, expected) {
+				t.Errorf("decoded the (%v) data when expecting (%v)", check.Data, expected)
+			}
+		})
+
+		t.Run("named item", func(t *testing.T) {
+			response := selectFields(newContext("fields=id,name"), envelope.NewEnvelope(http.StatusOK, &fieldsTestItem{ID: 1, Name: "name"}))
+			expected := fieldsTestItem{XMLName: xml.Name{Local: "item"}, ID: 1, Name: "name"}
+
+			check := struct {
+				Item fieldsTestItem `xml:"item"`
+			}{}
+			if data, e := xml.Marshal(response); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if e := xml.Unmarshal(data, &check); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if !reflect.DeepEqual(check.Item, expected) {
+				t.Errorf("decoded the (%v) data when expecting (%v)", check.Item, expected)
+			}
+		})
+	})
+}
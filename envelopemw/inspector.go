@@ -0,0 +1,90 @@
+package envelopemw
+
+import (
+	"sort"
+	"sync"
+)
+
+// Config defines the effective configuration of a generated
+// envelope middleware.
+type Config struct {
+	Endpoint   string
+	ServiceID  int
+	EndpointID int
+	Accept     []string
+	Envelope   bool
+	Status     int
+	Problem    bool
+}
+
+// IInspector defines the interface of an instance that gives access
+// to the current effective configuration of the generated middlewares.
+type IInspector interface {
+	Register(endpoint string, snapshot func() Config) error
+	Endpoints() []string
+	Config(endpoint string) (*Config, error)
+}
+
+// Inspector defines an instance that stores the configuration
+// snapshot accessors of the generated middlewares.
+type Inspector struct {
+	mutex     sync.RWMutex
+	snapshots map[string]func() Config
+}
+
+var _ IInspector = &Inspector{}
+
+// NewInspector will instantiate a new empty middleware inspector.
+func NewInspector() IInspector {
+	return &Inspector{
+		snapshots: map[string]func() Config{},
+	}
+}
+
+// Register will store the configuration snapshot accessor of the
+// middleware generated for the given endpoint. A middleware generated
+// again for the same endpoint replaces the previous accessor.
+func (i *Inspector) Register(
+	endpoint string,
+	snapshot func() Config,
+) error {
+	// check the snapshot argument reference
+	if snapshot == nil {
+		return errNilPointer("snapshot")
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.snapshots[endpoint] = snapshot
+	return nil
+}
+
+// Endpoints will retrieve the sorted list of the endpoints
+// with a generated middleware.
+func (i *Inspector) Endpoints() []string {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	endpoints := make([]string, 0, len(i.snapshots))
+	for endpoint := range i.snapshots {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// Config will retrieve the current effective configuration of the
+// middleware generated for the given endpoint.
+func (i *Inspector) Config(
+	endpoint string,
+) (*Config, error) {
+	i.mutex.RLock()
+	snapshot, ok := i.snapshots[endpoint]
+	i.mutex.RUnlock()
+
+	if !ok {
+		return nil, errEndpointNotFound(endpoint)
+	}
+	cfg := snapshot()
+	return &cfg, nil
+}
@@ -0,0 +1,75 @@
+package envelopemw
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewInspector(t *testing.T) {
+	t.Run("new inspector", func(t *testing.T) {
+		if sut := NewInspector(); sut == nil {
+			t.Error("didn't returned a valid reference")
+		} else if check := sut.Endpoints(); len(check) != 0 {
+			t.Errorf("stored the (%v) endpoints", check)
+		}
+	})
+}
+
+func Test_Inspector_Register(t *testing.T) {
+	t.Run("nil snapshot", func(t *testing.T) {
+		if e := NewInspector().Register("endpoint", nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("replace the endpoint snapshot", func(t *testing.T) {
+		sut := NewInspector()
+		_ = sut.Register("endpoint", func() Config { return Config{Status: 1} })
+		_ = sut.Register("endpoint", func() Config { return Config{Status: 2} })
+
+		if check, _ := sut.Config("endpoint"); check.Status != 2 {
+			t.Errorf("inspected the (%v) config", check)
+		}
+	})
+}
+
+func Test_Inspector_Endpoints(t *testing.T) {
+	t.Run("retrieve the sorted endpoints", func(t *testing.T) {
+		sut := NewInspector()
+		_ = sut.Register("second", func() Config { return Config{} })
+		_ = sut.Register("first", func() Config { return Config{} })
+
+		if check := sut.Endpoints(); !reflect.DeepEqual(check, []string{"first", "second"}) {
+			t.Errorf("retrieved the (%v) endpoints", check)
+		}
+	})
+}
+
+func Test_Inspector_Config(t *testing.T) {
+	t.Run("endpoint not found", func(t *testing.T) {
+		if check, e := NewInspector().Config("endpoint"); check != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrEndpointNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrEndpointNotFound)
+		}
+	})
+
+	t.Run("retrieve the current snapshot", func(t *testing.T) {
+		status := 200
+		sut := NewInspector()
+		_ = sut.Register("endpoint", func() Config { return Config{Endpoint: "endpoint", Status: status} })
+
+		first, _ := sut.Config("endpoint")
+		status = 201
+		second, _ := sut.Config("endpoint")
+
+		if first.Status != 200 || second.Status != 201 {
+			t.Errorf("inspected the (%v) and (%v) configs", first, second)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package envelopemw
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// resolveLinks will populate the response list report page links,
+// convert the response report and hypermedia relative links into absolute
+// links and emit them as RFC 8288 Link headers if configured.
+func resolveLinks(
+	ctx *gin.Context,
+	response *envelope.Envelope,
+) {
+	// no-op if there is no report or hypermedia link to be handled
+	if response.ListReport == nil && response.CursorReport == nil && len(response.Links) == 0 {
+		return
+	}
+	// no-op if the links aren't to be resolved or emitted
+	if !AbsoluteLinks && !LinkHeader {
+		return
+	}
+	// resolve the links against the current request URL
+	resolveReports(ctx.Request, response.ListReport, response.CursorReport, response.Links)
+	// emit the report links as Link headers
+	if LinkHeader {
+		var links []string
+		if r := response.ListReport; r != nil {
+			links = appendLink(links, r.First, "first")
+			links = appendLink(links, r.Prev, "prev")
+			links = appendLink(links, r.Next, "next")
+			links = appendLink(links, r.Last, "last")
+		}
+		if r := response.CursorReport; r != nil {
+			links = appendLink(links, r.Prev, "prev")
+			links = appendLink(links, r.Next, "next")
+		}
+		for _, link := range response.Links {
+			// templated links aren't valid link header targets
+			if !link.Templated {
+				links = appendLink(links, link.Href, link.Rel)
+			}
+		}
+		if len(links) != 0 {
+			ctx.Header("Link", strings.Join(links, ", "))
+		}
+	}
+}
+
+// resolveReports will populate the list report page links, prefix the
+// root-absolute report and hypermedia links with the trusted forwarded
+// prefix and resolve them against the request URL if configured.
+func resolveReports(
+	req *http.Request,
+	list *envelope.ListReport,
+	cursor *envelope.CursorReport,
+	links envelope.LinkList,
+) {
+	// apply the forwarded prefix to all the root-absolute links
+	prefix := forwardedPrefix(req)
+	if list != nil {
+		list.SetPageLinks()
+		list.First = prefixLink(prefix, list.First)
+		list.Prev = prefixLink(prefix, list.Prev)
+		list.Next = prefixLink(prefix, list.Next)
+		list.Last = prefixLink(prefix, list.Last)
+	}
+	if cursor != nil {
+		cursor.Prev = prefixLink(prefix, cursor.Prev)
+		cursor.Next = prefixLink(prefix, cursor.Next)
+	}
+	for _, link := range links {
+		link.Href = prefixLink(prefix, link.Href)
+	}
+	// resolve the links against the current request URL
+	if AbsoluteLinks {
+		base := requestURL(req)
+		if list != nil {
+			list.Resolve(base)
+		}
+		if cursor != nil {
+			cursor.Resolve(base)
+		}
+		for _, link := range links {
+			link.Resolve(base)
+		}
+	}
+}
+
+// requestURL will compose the absolute URL of the given request taking
+// in consideration the X-Forwarded-* headers set by the trusted reverse
+// proxies.
+func requestURL(
+	req *http.Request,
+) *url.URL {
+	// check the request and request URL references
+	if req == nil || req.URL == nil {
+		return nil
+	}
+	// discover the request scheme, host and path
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	host := req.Host
+	// override them with the forwarded values, only if the request
+	// was sent by a trusted proxy and the values are valid
+	if trustedProxy(req.RemoteAddr) {
+		if proto := strings.ToLower(forwarded(req, "X-Forwarded-Proto")); proto == "http" || proto == "https" {
+			scheme = proto
+		}
+		if fhost := forwarded(req, "X-Forwarded-Host"); validHost(fhost) {
+			host = fhost
+		}
+	}
+	return &url.URL{
+		Scheme:   scheme,
+		Host:     host,
+		Path:     prefixLink(forwardedPrefix(req), req.URL.Path),
+		RawQuery: req.URL.RawQuery,
+	}
+}
+
+// forwardedPrefix will retrieve the path prefix forwarded by a trusted
+// reverse proxy, if any.
+func forwardedPrefix(
+	req *http.Request,
+) string {
+	if req == nil || !trustedProxy(req.RemoteAddr) {
+		return ""
+	}
+	return strings.Trim(path.Clean("/"+forwarded(req, "X-Forwarded-Prefix")), "/")
+}
+
+// prefixLink will prepend the given path prefix to a root-absolute link,
+// leaving the relative, network-path and absolute links untouched.
+func prefixLink(
+	prefix,
+	link string,
+) string {
+	if prefix == "" || !strings.HasPrefix(link, "/") || strings.HasPrefix(link, "//") {
+		return link
+	}
+	return "/" + prefix + link
+}
+
+func forwarded(
+	req *http.Request,
+	header string,
+) string {
+	// retrieve the first entry of a possible proxy chain list
+	value := strings.Split(req.Header.Get(header), ",")[0]
+	return strings.TrimSpace(value)
+}
+
+func trustedProxy(
+	remoteAddr string,
+) bool {
+	// parse the remote address IP, with or without port
+	host, _, e := net.SplitHostPort(remoteAddr)
+	if e != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	// check the IP against the trusted addresses and ranges
+	for _, entry := range strings.Split(TrustedProxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if _, network, e := net.ParseCIDR(entry); e == nil {
+			if network.Contains(ip) {
+				return true
+			}
+		} else if trusted := net.ParseIP(entry); trusted != nil && trusted.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func validHost(
+	host string,
+) bool {
+	// the host must be a plain host name or address with optional port
+	if host == "" {
+		return false
+	}
+	u, e := url.Parse("//" + host)
+	return e == nil && u.Host == host && u.User == nil && u.Path == "" && u.RawQuery == "" && u.Fragment == ""
+}
+
+func appendLink(
+	links []string,
+	link,
+	rel string,
+) []string {
+	if link == "" {
+		return links
+	}
+	return append(links, fmt.Sprintf("<%s>; rel=%q", link, rel))
+}
@@ -0,0 +1,325 @@
+package envelopemw
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func Test_resolveLinks(t *testing.T) {
+	prevAbsolute, prevHeader := AbsoluteLinks, LinkHeader
+	AbsoluteLinks, LinkHeader = true, true
+	defer func() { AbsoluteLinks, LinkHeader = prevAbsolute, prevHeader }()
+
+	newContext := func(target string, header http.Header) (*gin.Context, *httptest.ResponseRecorder) {
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range header {
+			ctx.Request.Header[k] = v
+		}
+		return ctx, writer
+	}
+
+	t.Run("no-op on envelope without report", func(t *testing.T) {
+		ctx, writer := newContext("http://domain.com/resource", nil)
+		resolveLinks(ctx, envelope.NewEnvelope(200, nil))
+
+		if check := writer.Header().Get("Link"); check != "" {
+			t.Errorf("emitted the unexpected (%v) link header", check)
+		}
+	})
+
+	t.Run("resolve list report links and emit link header", func(t *testing.T) {
+		ctx, writer := newContext("http://domain.com/resource?search=term&start=2&count=2", nil)
+		response := envelope.NewEnvelope(200, nil, envelope.NewListReport("term", 2, 2, 6))
+		resolveLinks(ctx, response)
+
+		first := "http://domain.com/resource?search=term&start=0&count=2"
+		prev := "http://domain.com/resource?search=term&start=0&count=2"
+		next := "http://domain.com/resource?search=term&start=4&count=2"
+		last := "http://domain.com/resource?search=term&start=4&count=2"
+		header := `<` + first + `>; rel="first", <` + prev + `>; rel="prev", <` + next + `>; rel="next", <` + last + `>; rel="last"`
+
+		switch {
+		case response.ListReport.First != first:
+			t.Errorf("resolved the (%v) first link when expecting (%v)", response.ListReport.First, first)
+		case response.ListReport.Prev != prev:
+			t.Errorf("resolved the (%v) prev link when expecting (%v)", response.ListReport.Prev, prev)
+		case response.ListReport.Next != next:
+			t.Errorf("resolved the (%v) next link when expecting (%v)", response.ListReport.Next, next)
+		case response.ListReport.Last != last:
+			t.Errorf("resolved the (%v) last link when expecting (%v)", response.ListReport.Last, last)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("resolve cursor report links and emit link header", func(t *testing.T) {
+		ctx, writer := newContext("http://domain.com/resource?count=2", nil)
+		response := envelope.NewEnvelope(200, nil).SetCursorReport(envelope.NewCursorReport(url.Values{}, 2, "", "next"))
+		resolveLinks(ctx, response)
+
+		next := "http://domain.com/resource?count=2&cursor=next"
+		header := `<` + next + `>; rel="next"`
+
+		switch {
+		case response.CursorReport.Prev != "":
+			t.Errorf("resolved the (%v) prev link", response.CursorReport.Prev)
+		case response.CursorReport.Next != next:
+			t.Errorf("resolved the (%v) next link when expecting (%v)", response.CursorReport.Next, next)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("resolve hypermedia links and emit link header", func(t *testing.T) {
+		ctx, writer := newContext("http://domain.com/users/1", nil)
+		response := envelope.NewEnvelope(200, nil).
+			AddLink(envelope.LinkSelf, envelope.NewLink("/users/1")).
+			AddLink("find", envelope.NewLink("/users/{id}").SetTemplated(true))
+		resolveLinks(ctx, response)
+
+		self := "http://domain.com/users/1"
+		find := "http://domain.com/users/{id}"
+		header := `<` + self + `>; rel="self"`
+
+		switch {
+		case response.Links[0].Href != self:
+			t.Errorf("resolved the (%v) self link when expecting (%v)", response.Links[0].Href, self)
+		case response.Links[1].Href != find:
+			t.Errorf("resolved the (%v) templated link when expecting (%v)", response.Links[1].Href, find)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("prefix root-absolute links with the trusted forwarded prefix", func(t *testing.T) {
+		prev := TrustedProxies
+		TrustedProxies = "192.0.2.1"
+		defer func() { TrustedProxies = prev }()
+
+		ctx, writer := newContext("http://domain.com/users?count=2", http.Header{"X-Forwarded-Prefix": {"/api/"}})
+		response := envelope.NewEnvelope(200, nil).
+			SetCursorReport(envelope.NewCursorReport(url.Values{}, 2, "", "next")).
+			AddLink(envelope.LinkSelf, envelope.NewLink("/users/1")).
+			AddLink("find", envelope.NewLink("/users/{id}").SetTemplated(true)).
+			AddLink("docs", envelope.NewLink("//docs.domain.com/users"))
+		resolveLinks(ctx, response)
+
+		next := "http://domain.com/api/users?count=2&cursor=next"
+		self := "http://domain.com/api/users/1"
+		find := "http://domain.com/api/users/{id}"
+		docs := "http://docs.domain.com/users"
+		header := `<` + next + `>; rel="next", <` + self + `>; rel="self", <` + docs + `>; rel="docs"`
+
+		switch {
+		case response.CursorReport.Next != next:
+			t.Errorf("resolved the (%v) next link when expecting (%v)", response.CursorReport.Next, next)
+		case response.Links[0].Href != self:
+			t.Errorf("resolved the (%v) self link when expecting (%v)", response.Links[0].Href, self)
+		case response.Links[1].Href != find:
+			t.Errorf("resolved the (%v) templated link when expecting (%v)", response.Links[1].Href, find)
+		case response.Links[2].Href != docs:
+			t.Errorf("resolved the (%v) network-path link when expecting (%v)", response.Links[2].Href, docs)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("prefix root-absolute links without resolving them", func(t *testing.T) {
+		prevTrusted, prevAbsolute := TrustedProxies, AbsoluteLinks
+		TrustedProxies, AbsoluteLinks = "192.0.2.1", false
+		defer func() { TrustedProxies, AbsoluteLinks = prevTrusted, prevAbsolute }()
+
+		ctx, writer := newContext("http://domain.com/users/1", http.Header{"X-Forwarded-Prefix": {"api"}})
+		response := envelope.NewEnvelope(200, nil).AddLink(envelope.LinkSelf, envelope.NewLink("/users/1"))
+		resolveLinks(ctx, response)
+
+		self := "/api/users/1"
+		header := `<` + self + `>; rel="self"`
+
+		switch {
+		case response.Links[0].Href != self:
+			t.Errorf("stored the (%v) self link when expecting (%v)", response.Links[0].Href, self)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("don't prefix links from an untrusted address", func(t *testing.T) {
+		ctx, _ := newContext("http://domain.com/users/1", http.Header{"X-Forwarded-Prefix": {"/api"}})
+		response := envelope.NewEnvelope(200, nil).AddLink(envelope.LinkSelf, envelope.NewLink("/users/1"))
+		resolveLinks(ctx, response)
+
+		if check := response.Links[0].Href; check != "http://domain.com/users/1" {
+			t.Errorf("resolved the (%v) self link", check)
+		}
+	})
+
+	t.Run("don't resolve links if disabled by environment", func(t *testing.T) {
+		prev := AbsoluteLinks
+		AbsoluteLinks = false
+		defer func() { AbsoluteLinks = prev }()
+
+		ctx, writer := newContext("http://domain.com/resource", nil)
+		response := envelope.NewEnvelope(200, nil, envelope.NewListReport("", 0, 2, 2))
+		resolveLinks(ctx, response)
+
+		first := "?search=&start=0&count=2"
+		header := `<` + first + `>; rel="first", <` + first + `>; rel="last"`
+
+		switch {
+		case response.ListReport.First != first:
+			t.Errorf("resolved the (%v) first link when expecting (%v)", response.ListReport.First, first)
+		case writer.Header().Get("Link") != header:
+			t.Errorf("emitted the (%v) link header when expecting (%v)", writer.Header().Get("Link"), header)
+		}
+	})
+
+	t.Run("don't populate the page links if disabled by environment", func(t *testing.T) {
+		prevAbsolute, prevHeader := AbsoluteLinks, LinkHeader
+		AbsoluteLinks, LinkHeader = false, false
+		defer func() { AbsoluteLinks, LinkHeader = prevAbsolute, prevHeader }()
+
+		ctx, writer := newContext("http://domain.com/resource", nil)
+		response := envelope.NewEnvelope(200, nil, envelope.NewListReport("", 0, 2, 2))
+		resolveLinks(ctx, response)
+
+		switch {
+		case response.ListReport.First != "" || response.ListReport.Last != "":
+			t.Errorf("stored the unexpected (%v) and (%v) first and last links", response.ListReport.First, response.ListReport.Last)
+		case writer.Header().Get("Link") != "":
+			t.Errorf("emitted the unexpected (%v) link header", writer.Header().Get("Link"))
+		}
+	})
+
+	t.Run("don't emit link header if disabled by environment", func(t *testing.T) {
+		prev := LinkHeader
+		LinkHeader = false
+		defer func() { LinkHeader = prev }()
+
+		ctx, writer := newContext("http://domain.com/resource", nil)
+		resolveLinks(ctx, envelope.NewEnvelope(200, nil, envelope.NewListReport("", 0, 2, 2)))
+
+		if check := writer.Header().Get("Link"); check != "" {
+			t.Errorf("emitted the unexpected (%v) link header", check)
+		}
+	})
+}
+
+func Test_requestURL(t *testing.T) {
+	t.Run("nil request", func(t *testing.T) {
+		if check := requestURL(nil); check != nil {
+			t.Errorf("returned the unexpected (%v) url", check)
+		}
+	})
+
+	t.Run("request without url", func(t *testing.T) {
+		if check := requestURL(&http.Request{}); check != nil {
+			t.Errorf("returned the unexpected (%v) url", check)
+		}
+	})
+
+	t.Run("compose request url", func(t *testing.T) {
+		forwardedHeader := http.Header{
+			"X-Forwarded-Proto":  {"https"},
+			"X-Forwarded-Host":   {"api.domain.com, proxy.domain.com"},
+			"X-Forwarded-Prefix": {"/v1/"},
+		}
+
+		scenarios := []struct {
+			test     string
+			target   string
+			tls      bool
+			trusted  string
+			remote   string
+			header   http.Header
+			expected string
+		}{
+			{ // plain request
+				test:     "plain request",
+				target:   "http://domain.com/resource?search=term",
+				expected: "http://domain.com/resource?search=term",
+			},
+			{ // tls request
+				test:     "tls request",
+				target:   "http://domain.com:8443/resource",
+				tls:      true,
+				expected: "https://domain.com:8443/resource",
+			},
+			{ // forwarded request from an untrusted address
+				test:     "forwarded request from an untrusted address",
+				target:   "http://localhost:8080/resource",
+				header:   forwardedHeader,
+				expected: "http://localhost:8080/resource",
+			},
+			{ // forwarded request from a trusted address
+				test:     "forwarded request from a trusted address",
+				target:   "http://localhost:8080/resource",
+				trusted:  "10.0.0.1, 192.0.2.1",
+				header:   forwardedHeader,
+				expected: "https://api.domain.com/v1/resource",
+			},
+			{ // forwarded request from a trusted range
+				test:     "forwarded request from a trusted range",
+				target:   "http://localhost:8080/resource",
+				trusted:  "10.0.0.0/8",
+				remote:   "10.1.2.3:5000",
+				header:   forwardedHeader,
+				expected: "https://api.domain.com/v1/resource",
+			},
+			{ // forwarded request from an address outside the trusted range
+				test:     "forwarded request from an address outside the trusted range",
+				target:   "http://localhost:8080/resource",
+				trusted:  "10.0.0.0/8, invalid",
+				remote:   "invalid",
+				header:   forwardedHeader,
+				expected: "http://localhost:8080/resource",
+			},
+			{ // forwarded request with an invalid scheme
+				test:     "forwarded request with an invalid scheme",
+				target:   "http://localhost:8080/resource",
+				trusted:  "192.0.2.1",
+				header:   http.Header{"X-Forwarded-Proto": {"javascript"}},
+				expected: "http://localhost:8080/resource",
+			},
+			{ // forwarded request with an invalid host
+				test:     "forwarded request with an invalid host",
+				target:   "http://localhost:8080/resource",
+				trusted:  "192.0.2.1",
+				header:   http.Header{"X-Forwarded-Host": {"user@evil.com/path"}},
+				expected: "http://localhost:8080/resource",
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				prev := TrustedProxies
+				TrustedProxies = s.trusted
+				defer func() { TrustedProxies = prev }()
+
+				req := httptest.NewRequest(http.MethodGet, s.target, nil)
+				if s.tls {
+					req.TLS = &tls.ConnectionState{}
+				}
+				if s.remote != "" {
+					req.RemoteAddr = s.remote
+				}
+				for k, v := range s.header {
+					req.Header[k] = v
+				}
+
+				if check := requestURL(req).String(); check != s.expected {
+					t.Errorf("composed the (%v) url when expecting (%v)", check, s.expected)
+				}
+			})
+		}
+	})
+}
@@ -1,8 +1,12 @@
 package envelopemw
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gin-gonic/gin"
 	"github.com/happyhippyhippo/slate-rest"
@@ -21,6 +25,10 @@ type MiddlewareGenerator func(string) (rest.Middleware, error)
 func NewMiddlewareGenerator(
 	cfg config.IManager,
 	logger log.ILog,
+	renderers IRendererRegistry,
+	mapper IErrorMapper,
+	hooks IPanicHooks,
+	inspector IInspector,
 ) (MiddlewareGenerator, error) {
 	// check the config argument reference
 	if cfg == nil {
@@ -30,6 +38,22 @@ func NewMiddlewareGenerator(
 	if logger == nil {
 		return nil, errNilPointer("logger")
 	}
+	// check the renderers argument reference
+	if renderers == nil {
+		return nil, errNilPointer("renderers")
+	}
+	// check the error mapper argument reference
+	if mapper == nil {
+		return nil, errNilPointer("mapper")
+	}
+	// check the panic hooks argument reference
+	if hooks == nil {
+		return nil, errNilPointer("hooks")
+	}
+	// check the inspector argument reference
+	if inspector == nil {
+		return nil, errNilPointer("inspector")
+	}
 	// validate log level
 	logLevel, ok := log.LevelMap[LogLevel]
 	if !ok {
@@ -41,16 +65,6 @@ func NewMiddlewareGenerator(
 		_ = logger.Signal(LogChannel, logLevel, LogServiceErrorMessage, log.Context{"error": e})
 		return nil, e
 	}
-	// add a config observer for the service ID
-	_ = cfg.AddObserver(ServiceIDConfigPath, func(old interface{}, new interface{}) {
-		// new value type check for integer
-		tnew, ok := new.(int)
-		if !ok {
-			_ = logger.Signal(LogChannel, logLevel, LogServiceErrorMessage, log.Context{"value": new})
-			return
-		}
-		service = tnew
-	})
 	// retrieve the service REST accepted format list
 	acceptedList, e := cfg.List(FormatAcceptListConfigPath)
 	if e != nil {
@@ -64,25 +78,48 @@ func NewMiddlewareGenerator(
 			accepted = append(accepted, tv)
 		}
 	}
+	// store the service configuration snapshot, that is atomically
+	// swapped by the observers so the requests always read a
+	// consistent configuration
+	var mutex sync.Mutex
+	var snapshot atomic.Value
+	snapshot.Store(&serviceConfig{id: service, accepted: accepted})
+	// add a config observer for the service ID
+	_ = cfg.AddObserver(ServiceIDConfigPath, func(old interface{}, new interface{}) {
+		// new value type check for integer
+		tnew, ok := new.(int)
+		if !ok {
+			_ = logger.Signal(LogChannel, logLevel, LogServiceErrorMessage, log.Context{"value": new})
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		current := snapshot.Load().(*serviceConfig)
+		snapshot.Store(&serviceConfig{id: tnew, accepted: current.accepted})
+	})
 	// add a config observer for the REST accepted format list
 	_ = cfg.AddObserver(FormatAcceptListConfigPath, func(old interface{}, new interface{}) {
-		accepted = []string{}
 		// new value type check for an array
 		tnew, ok := new.([]interface{})
 		if !ok {
 			_ = logger.Signal(LogChannel, logLevel, LogAcceptListErrorMessage, log.Context{"list": new})
 			return
 		}
-		// iterate through all the array elements
+		// parse all the array elements, discarding the whole
+		// list if any element is not a string
+		var list []string
 		for _, v := range tnew {
-			// type check for a string
-			if tv, ok := v.(string); !ok {
+			tv, ok := v.(string)
+			if !ok {
 				_ = logger.Signal(LogChannel, logLevel, LogAcceptListErrorMessage, log.Context{"value": v})
-			} else {
-				// add the iterated element to the accepted format list
-				accepted = append(accepted, tv)
+				return
 			}
+			list = append(list, tv)
 		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		current := snapshot.Load().(*serviceConfig)
+		snapshot.Store(&serviceConfig{id: current.id, accepted: list})
 	})
 	// return the middleware generator
 	return func(
@@ -95,6 +132,23 @@ func NewMiddlewareGenerator(
 			_ = logger.Signal(LogChannel, logLevel, LogEndpointErrorMessage, log.Context{"error": e})
 			return nil, e
 		}
+		// retrieve the endpoint envelope handling configuration
+		endpointConfigPath := fmt.Sprintf(EndpointConfigPathFormat, id)
+		block, e := cfg.Config(endpointConfigPath, config.Config{})
+		if e != nil {
+			_ = logger.Signal(LogChannel, logLevel, LogEndpointErrorMessage, log.Context{"error": e})
+			return nil, e
+		}
+		settings, e := newEndpointConfig(block)
+		if e != nil {
+			_ = logger.Signal(LogChannel, logLevel, endpointErrorMessage(e), log.Context{"error": e})
+			return nil, e
+		}
+		settings.id = endpoint
+		// store the endpoint configuration snapshot
+		var endpointMutex sync.Mutex
+		var endpointSnapshot atomic.Value
+		endpointSnapshot.Store(settings)
 		// add a config observer for the endpoint id integer value
 		_ = cfg.AddObserver(endpointIDConfigPath, func(old interface{}, new interface{}) {
 			// new value type check for integer
@@ -103,7 +157,44 @@ func NewMiddlewareGenerator(
 				_ = logger.Signal(LogChannel, logLevel, LogEndpointErrorMessage, log.Context{"value": new})
 				return
 			}
-			endpoint = tnew
+			endpointMutex.Lock()
+			defer endpointMutex.Unlock()
+			current := *endpointSnapshot.Load().(*endpointConfig)
+			current.id = tnew
+			endpointSnapshot.Store(&current)
+		})
+		// add a config observer for the endpoint configuration block
+		_ = cfg.AddObserver(endpointConfigPath, func(old interface{}, new interface{}) {
+			// reload the endpoint configuration, keeping the current
+			// one if the new configuration is invalid
+			block, e := cfg.Config(endpointConfigPath, config.Config{})
+			if e != nil {
+				_ = logger.Signal(LogChannel, logLevel, LogEndpointErrorMessage, log.Context{"error": e})
+				return
+			}
+			tnew, e := newEndpointConfig(block)
+			if e != nil {
+				_ = logger.Signal(LogChannel, logLevel, endpointErrorMessage(e), log.Context{"error": e})
+				return
+			}
+			endpointMutex.Lock()
+			defer endpointMutex.Unlock()
+			tnew.id = endpointSnapshot.Load().(*endpointConfig).id
+			endpointSnapshot.Store(tnew)
+		})
+		// register the middleware effective configuration accessor
+		_ = inspector.Register(id, func() Config {
+			svc := snapshot.Load().(*serviceConfig)
+			ept := endpointSnapshot.Load().(*endpointConfig)
+			return Config{
+				Endpoint:   id,
+				ServiceID:  svc.id,
+				EndpointID: ept.id,
+				Accept:     append([]string{}, ept.formats(svc.accepted)...),
+				Envelope:   ept.envelope,
+				Status:     ept.status,
+				Problem:    ept.problem,
+			}
 		})
 		// return the generated middleware function
 		return func(
@@ -113,47 +204,91 @@ func NewMiddlewareGenerator(
 			return func(
 				ctx *gin.Context,
 			) {
+				// retrieve the configuration snapshots used by the request
+				svc := snapshot.Load().(*serviceConfig)
+				settings := endpointSnapshot.Load().(*endpointConfig)
+				service, endpoint, accepted := svc.id, settings.id, svc.accepted
 				// declare the result parsing method
 				parse := func(val interface{}) {
+					// write the stream envelopes as the records are produced
+					if stream, ok := val.(*envelope.StreamEnvelope); ok {
+						if stream.StatusCode == 0 {
+							stream.StatusCode = settings.status
+						}
+						streamResponse(ctx, mapper, settings.formats(accepted), stream, service, endpoint)
+						return
+					}
 					var response *envelope.Envelope
 					// type check the value to be enveloped
 					switch v := val.(type) {
 					case *envelope.Envelope:
 						// just set the result as the envelope reference
 						response = v
+					case envelope.IEnvelope:
+						// convert the typed envelope into the
+						// response envelope
+						response = v.Envelope()
 					case error:
-						// set the result as a new envelope with an
-						// internal server error with the given error as the
-						// error message
-						response =
-							envelope.NewEnvelope(http.StatusInternalServerError, nil).
-								AddError(envelope.NewStatusError(0, v.Error()))
+						// set the result as the envelope mapped from the
+						// error, or as a new envelope with an internal server
+						// error with a generic error message if the error
+						// is not mapped
+						response = mapper.Map(v)
+						if response == nil {
+							response =
+								envelope.NewEnvelope(http.StatusInternalServerError, nil).
+									AddError(envelope.NewStatusError(0, "internal server error"))
+						}
+						response = exposeError(response, v)
 					default:
 						// set the result as a new envelope with an
 						// internal server error with a generic error message
-						response =
+						response = exposeError(
 							envelope.NewEnvelope(http.StatusInternalServerError, nil).
-								AddError(envelope.NewStatusError(0, "internal server error"))
+								AddError(envelope.NewStatusError(0, "internal server error")),
+							v,
+						)
+					}
+					// assign the endpoint default status to the successful
+					// responses without a status
+					if response.StatusCode == 0 && (response.Status == nil || response.Status.Success) {
+						response.StatusCode = settings.status
 					}
-					// try to negotiate the response format with the defined
-					// accepted format mime types giving the response envelope
-					// as the content data of the response
-					ctx.Negotiate(
-						response.GetStatusCode(),
-						gin.Negotiate{
-							Offered: accepted,
-							Data:    response.SetService(service).SetEndpoint(endpoint),
-						},
-					)
+					// prune the response data to the requested fieldsets
+					response = selectFields(ctx, response)
+					// assign the service and endpoint codes to the response
+					response = response.SetService(service).SetEndpoint(endpoint)
+					// resolve the response report links
+					resolveLinks(ctx, response)
+					// render the response with the negotiated format
+					renderResponse(ctx, renderers, settings, accepted, response, service, endpoint)
 				}
 				// always try to fallback retrieve any error to be parsed
 				// and result in a proper envelope
 				defer func() {
 					if e := recover(); e != nil {
-						parse(e)
+						// log and report the recovered panic
+						stack := debug.Stack()
+						_ = logger.Signal(LogChannel, logLevel, LogPanicMessage, log.Context{
+							"endpoint": id,
+							"service":  service,
+							"code":     endpoint,
+							"panic":    fmt.Sprintf("%v", e),
+							"stack":    string(stack),
+						})
+						hooks.Call(ctx, e, stack)
+						// respond with a generic internal server error
+						parse(exposeError(
+							envelope.NewEnvelope(http.StatusInternalServerError, nil).
+								AddError(envelope.NewStatusError(0, "internal server error")),
+							e,
+						))
 					}
 				}()
-				// execute the middleware stored execution method
+				// signal the inner middlewares that the stored response
+				// will be rendered, and execute the middleware stored
+				// execution method
+				ctx.Set(rest.EnvelopedContextKey, true)
 				next(ctx)
 				// check if the response as been stored in the context to be
 				// correctly parsed
@@ -164,3 +299,101 @@ func NewMiddlewareGenerator(
 		}, nil
 	}, nil
 }
+
+// endpointErrorMessage will select the logging message of an endpoint
+// configuration parsing error, using the accept list error message for
+// the invalid endpoint accepted format lists.
+func endpointErrorMessage(
+	e error,
+) string {
+	if errors.Is(e, ErrInvalidAcceptList) {
+		return LogAcceptListErrorMessage
+	}
+	return LogEndpointErrorMessage
+}
+
+// serviceConfig defines the service level envelope
+// handling configuration.
+type serviceConfig struct {
+	id       int
+	accepted []string
+}
+
+// renderResponse will negotiate the response format and write the
+// response with the renderer registered for the negotiated mime type. If
+// no registered renderer can satisfy the request a not acceptable error
+// envelope is written in the first available endpoint accepted format.
+func renderResponse(
+	ctx *gin.Context,
+	renderers IRendererRegistry,
+	settings *endpointConfig,
+	accepted []string,
+	response *envelope.Envelope,
+	service,
+	endpoint int,
+) {
+	// filter the accepted formats that have a registered renderer
+	var offered []string
+	for _, mime := range settings.formats(accepted) {
+		if _, e := renderers.Get(mime); e == nil {
+			offered = append(offered, mime)
+		}
+	}
+	// negotiate the response format, giving priority to the
+	// problem details formats for error responses
+	status := response.GetStatusCode()
+	var data interface{} = response
+	if !settings.envelope && !response.IsBatch() && (response.Status == nil || response.Status.Success) {
+		// write only the data of the successful responses if the
+		// endpoint is configured to not envelope them
+		data = response.Data
+	}
+	format := problemFormat(ctx, response, offered, settings.problem)
+	if _, e := renderers.Get(format); e == nil {
+		data = newProblem(ctx, response)
+	} else if len(offered) != 0 {
+		format = ctx.NegotiateFormat(offered...)
+		// fallback to the plain equivalent of a requested problem
+		// details format for the responses that can't be rendered as
+		// problem details
+		if format == "" {
+			format = problemEquivalentFormat(ctx, offered)
+		}
+	}
+	// retrieve the negotiated format renderer
+	renderer, e := renderers.Get(format)
+	if e != nil {
+		// check if there is a format that can be used to
+		// write the not acceptable error envelope
+		if len(offered) == 0 {
+			ctx.AbortWithStatus(http.StatusNotAcceptable)
+			return
+		}
+		format = offered[0]
+		renderer, _ = renderers.Get(format)
+		// compose the not acceptable error envelope
+		status = http.StatusNotAcceptable
+		data = envelope.NewEnvelope(status, nil).
+			AddError(envelope.NewStatusError(0, "not acceptable")).
+			SetService(service).
+			SetEndpoint(endpoint)
+	}
+	// write the response
+	_ = renderer.Render(ctx, format, status, data)
+}
+
+// exposeError will assign the raw error description to all the given
+// envelope status errors if the exposure is enabled and the application is
+// not running in release (production) mode.
+func exposeError(
+	response *envelope.Envelope,
+	raw interface{},
+) *envelope.Envelope {
+	if !ExposeErrors || gin.Mode() == gin.ReleaseMode {
+		return response
+	}
+	for _, e := range response.Status.Errors {
+		e.SetRaw(raw)
+	}
+	return response
+}
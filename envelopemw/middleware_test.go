@@ -5,16 +5,30 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate-rest/cache"
 	"github.com/happyhippyhippo/slate-rest/envelope"
 	"github.com/happyhippyhippo/slate/config"
 	"github.com/happyhippyhippo/slate/log"
 )
 
+func newRendererRegistry() IRendererRegistry {
+	registry := NewRendererRegistry()
+	_ = registry.Register(NewRendererJSON())
+	_ = registry.Register(NewRendererXML())
+	_ = registry.Register(NewRendererYAML())
+	_ = registry.Register(NewRendererMsgPack())
+	return registry
+}
+
 func Test_NewMiddlewareGenerator(t *testing.T) {
 	t.Run("nil config", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -22,7 +36,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 
 		logger := NewMockLog(ctrl)
 
-		generator, e := NewMiddlewareGenerator(nil, logger)
+		generator, e := NewMiddlewareGenerator(nil, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -39,7 +53,79 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 
 		cfgManager := NewMockConfigManager(ctrl)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, nil)
+		generator, e := NewMiddlewareGenerator(cfgManager, nil, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil renderers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		logger := NewMockLog(ctrl)
+
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, nil, NewErrorMapper(), NewPanicHooks(), NewInspector())
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil error mapper", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		logger := NewMockLog(ctrl)
+
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), nil, NewPanicHooks(), NewInspector())
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil panic hooks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		logger := NewMockLog(ctrl)
+
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), nil, NewInspector())
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil inspector", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfgManager := NewMockConfigManager(ctrl)
+		logger := NewMockLog(ctrl)
+
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), nil)
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -60,7 +146,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogServiceErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -85,7 +171,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogServiceErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -110,7 +196,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogServiceErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -135,7 +221,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.WARNING, LogServiceErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -160,7 +246,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -178,12 +264,11 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		expected := fmt.Errorf("error message")
 		cfgManager := NewMockConfigManager(ctrl)
 		cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil).Times(1)
-		cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil).Times(1)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return(nil, expected).Times(1)
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogAcceptListErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -205,12 +290,11 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		expected := fmt.Errorf("error message")
 		cfgManager := NewMockConfigManager(ctrl)
 		cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil).Times(1)
-		cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil).Times(1)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return(nil, expected).Times(1)
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogAcceptListErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -232,12 +316,11 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		expected := fmt.Errorf("error message")
 		cfgManager := NewMockConfigManager(ctrl)
 		cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil).Times(1)
-		cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil).Times(1)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return(nil, expected).Times(1)
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.WARNING, LogAcceptListErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -259,12 +342,11 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		expected := fmt.Errorf("error message")
 		cfgManager := NewMockConfigManager(ctrl)
 		cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil).Times(1)
-		cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil).Times(1)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return(nil, expected).Times(1)
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator != nil:
 			t.Error("returned a valid reference")
@@ -288,7 +370,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, e := NewMiddlewareGenerator(cfgManager, logger)
+		generator, e := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		switch {
 		case generator == nil:
 			t.Error("didn't returned a valid reference")
@@ -316,7 +398,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, e := generator(endpoint)
 		switch {
 		case mw != nil:
@@ -351,7 +433,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogEndpointErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, e := generator(endpoint)
 		switch {
 		case mw != nil:
@@ -386,7 +468,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogEndpointErrorMessage, log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, e := generator(endpoint)
 		switch {
 		case mw != nil:
@@ -421,7 +503,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"error": expected}).Return(nil).Times(1)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, e := generator(endpoint)
 		switch {
 		case mw != nil:
@@ -444,6 +526,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -451,7 +535,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, e := generator(endpoint)
 		switch {
 		case mw == nil:
@@ -472,6 +556,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -479,12 +565,14 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		calls := 0
-		handler := mw(func(*gin.Context) {
+		enveloped := false
+		handler := mw(func(ctx *gin.Context) {
 			calls++
+			_, enveloped = ctx.Get(rest.EnvelopedContextKey)
 		})
 
 		gin.SetMode(gin.ReleaseMode)
@@ -495,6 +583,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 
 		if calls != 1 {
 			t.Errorf("didn't called the original underlying handler")
+		} else if !enveloped {
+			t.Errorf("didn't signaled the enveloped request")
 		}
 	})
 
@@ -509,6 +599,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -516,7 +608,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
@@ -536,7 +628,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		}
 	})
 
-	t.Run("parse error stored in the response field of context", func(t *testing.T) {
+	t.Run("parse list report envelope resolving the report links", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -547,6 +639,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -554,27 +648,36 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
+		prevAbsolute, prevHeader := AbsoluteLinks, LinkHeader
+		AbsoluteLinks, LinkHeader = true, true
+		defer func() { AbsoluteLinks, LinkHeader = prevAbsolute, prevHeader }()
+
 		handler := mw(func(ctx *gin.Context) {
-			ctx.Set("response", fmt.Errorf("error message"))
+			ctx.Set("response", envelope.NewEnvelope(200, []string{"data1"}, envelope.NewListReport("", 0, 1, 1)))
 		})
 
 		gin.SetMode(gin.ReleaseMode)
 		writer := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(writer)
-		ctx.Request = &http.Request{}
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource", nil)
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"error message"}]}}`
+		link := "http://domain.com/resource?search=&start=0&count=1"
+		jlink := `http://domain.com/resource?search=\u0026start=0\u0026count=1`
+		expected := `{"status":{"success":true,"error":[]},"report":{"search":"","start":0,"count":1,"total":1,"first":"` + jlink + `","prev":"","next":"","last":"` + jlink + `"},"data":["data1"]}`
+		header := `<` + link + `>; rel="first", <` + link + `>; rel="last"`
 
 		if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		} else if check := writer.Header().Get("Link"); check != header {
+			t.Errorf("emitted (%v) link header when expecting : %v", check, header)
 		}
 	})
 
-	t.Run("parse invalid stored in the response field of context", func(t *testing.T) {
+	t.Run("parse data envelope with the negotiated renderer", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -584,7 +687,9 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
-		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON, gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -592,27 +697,27 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
-			ctx.Set("response", "string message")
+			ctx.Set("response", envelope.NewEnvelope(200, "data", nil))
 		})
 
 		gin.SetMode(gin.ReleaseMode)
 		writer := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(writer)
-		ctx.Request = &http.Request{}
+		ctx.Request = &http.Request{Header: http.Header{"Accept": {gin.MIMEXML}}}
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+		expected := `<envelope><status><success>true</success><error></error></status><data>data</data></envelope>`
 
 		if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
 		}
 	})
 
-	t.Run("parse panic error", func(t *testing.T) {
+	t.Run("parse data envelope with a not acceptable envelope on failed negotiation", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -622,7 +727,9 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
-		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEHTML, gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -630,27 +737,29 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
-			panic(fmt.Errorf("error message"))
+			ctx.Set("response", envelope.NewEnvelope(200, "data", nil))
 		})
 
 		gin.SetMode(gin.ReleaseMode)
 		writer := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(writer)
-		ctx.Request = &http.Request{}
+		ctx.Request = &http.Request{Header: http.Header{"Accept": {gin.MIMEHTML}}}
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"error message"}]}}`
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"not acceptable"}]}}`
 
-		if check := writer.Body.String(); check != expected {
+		if check := writer.Code; check != http.StatusNotAcceptable {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
 		}
 	})
 
-	t.Run("panic non-error value", func(t *testing.T) {
+	t.Run("abort with not acceptable status if no accepted format has a renderer", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -660,7 +769,9 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
-		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEHTML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -668,11 +779,11 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
-			panic("string message")
+			ctx.Set("response", envelope.NewEnvelope(200, "data", nil))
 		})
 
 		gin.SetMode(gin.ReleaseMode)
@@ -681,19 +792,17 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		ctx.Request = &http.Request{}
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
-
-		if check := writer.Body.String(); check != expected {
-			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		if check := writer.Code; check != http.StatusNotAcceptable {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != "" {
+			t.Errorf("parsed the unexpected (%v) response data", check)
 		}
 	})
 
-	t.Run("registered observer update the service id value", func(t *testing.T) {
+	t.Run("parse error as problem details if requested", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
@@ -701,231 +810,1480 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
-			cfgManager.
-				EXPECT().
-				AddObserver(ServiceIDConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
 			ctx.Set("response", fmt.Errorf("error message"))
 		})
 
-		callback(1, 2)
-
 		gin.SetMode(gin.ReleaseMode)
 		writer := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(writer)
-		ctx.Request = &http.Request{}
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource", nil)
+		ctx.Request.Header.Set("Accept", envelope.MIMEProblemJSON)
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:2.e:2.c:0","message":"error message"}]}}`
+		expected := `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"internal server error","instance":"/resource","errors":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}`
 
-		if check := writer.Body.String(); check != expected {
+		if check := writer.Header().Get("Content-Type"); check != envelope.MIMEProblemJSON+"; charset=utf-8" {
+			t.Errorf("responded with the (%v) content type", check)
+		} else if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
 		}
 	})
 
-	t.Run("registered service id observer log on invalid new service id", func(t *testing.T) {
+	t.Run("parse error stored in the response field of context", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
-		newValue := "string"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
-			cfgManager.
-				EXPECT().
-				AddObserver(ServiceIDConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
-		logger.EXPECT().Signal(LogChannel, log.ERROR, LogServiceErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
-		_, _ = generator(endpoint)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
 
-		callback(1, newValue)
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", fmt.Errorf("error message"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
 	})
 
-	t.Run("registered service id observer log on invalid new service id with environment defined channel", func(t *testing.T) {
+	t.Run("parse invalid stored in the response field of context", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		prev := LogChannel
-		LogChannel = "test"
-		defer func() { LogChannel = prev }()
-
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
-		newValue := "string"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
-			cfgManager.
-				EXPECT().
-				AddObserver(ServiceIDConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
-		logger.EXPECT().Signal("test", log.ERROR, LogServiceErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
-		_, _ = generator(endpoint)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
 
-		callback(1, newValue)
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", "string message")
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
 	})
 
-	t.Run("registered service id observer log on invalid new service id with environment defined level", func(t *testing.T) {
+	t.Run("parse batch envelope", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		prev := LogLevel
-		LogLevel = log.LevelMapName[log.DEBUG]
-		defer func() { LogLevel = prev }()
-
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
-		newValue := "string"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
-			cfgManager.
-				EXPECT().
-				AddObserver(ServiceIDConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
-		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogServiceErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
-		_, _ = generator(endpoint)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
 
-		callback(1, newValue)
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewBatchEnvelope(
+				envelope.NewBatchItem("1", http.StatusCreated, nil),
+				envelope.NewBatchItem("2", http.StatusConflict, nil).AddError(envelope.NewStatusError(3, "conflict")),
+			))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[]},"items":[{"id":"1","statusCode":201,"status":{"success":true,"error":[]}},{"id":"2","statusCode":409,"status":{"success":false,"error":[{"code":"s:1.e:2.c:3","message":"conflict"}]}}]}`
+
+		if check := writer.Code; check != http.StatusMultiStatus {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
 	})
 
-	t.Run("registered service id observer log on invalid new service id with environment defined message", func(t *testing.T) {
+	t.Run("parse partial success batch envelope requested as problem details", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		prev := LogServiceErrorMessage
-		LogServiceErrorMessage = "test"
-		defer func() { LogServiceErrorMessage = prev }()
-
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
-		newValue := "string"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"problem": true}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
-			cfgManager.
-				EXPECT().
-				AddObserver(ServiceIDConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
-		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
-		_, _ = generator(endpoint)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
 
-		callback(1, newValue)
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewBatchEnvelope(
+				envelope.NewBatchItem("1", http.StatusCreated, nil),
+				envelope.NewBatchItem("2", http.StatusConflict, nil).AddError(envelope.NewStatusError(3, "conflict")),
+			))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{Header: http.Header{"Accept": {envelope.MIMEProblemJSON}}}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[]},"items":[{"id":"1","statusCode":201,"status":{"success":true,"error":[]}},{"id":"2","statusCode":409,"status":{"success":false,"error":[{"code":"s:1.e:2.c:3","message":"conflict"}]}}]}`
+
+		if check := writer.Code; check != http.StatusMultiStatus {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
 	})
 
-	t.Run("registered observer update the accept formats value", func(t *testing.T) {
+	t.Run("parse typed envelope", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		var callback func(old interface{}, new interface{})
-
 		endpoint := "index"
 		cfgManager := NewMockConfigManager(ctrl)
 		gomock.InOrder(
 			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
-		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
-			cfgManager.
-				EXPECT().
-				AddObserver(FormatAcceptListConfigPath, gomock.Any()).
-				DoAndReturn(func(id string, cb config.IObserver) error {
-					callback = cb
-					return nil
-				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
-			ctx.Set("response", fmt.Errorf("error message"))
+			ctx.Set("response", envelope.NewTypedEnvelope(http.StatusCreated, []int{1, 2}))
 		})
 
-		callback([]interface{}{gin.MIMEXML}, []interface{}{gin.MIMEJSON})
-
 		gin.SetMode(gin.ReleaseMode)
 		writer := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(writer)
 		ctx.Request = &http.Request{}
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"error message"}]}}`
+		expected := `{"status":{"success":true,"error":[]},"data":[1,2]}`
+
+		if check := writer.Code; check != http.StatusCreated {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("parse typed application error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", NewEnvelopeError(http.StatusNotFound, 12, "user not found", fmt.Errorf("sql: no rows")))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:12","message":"user not found"}]}}`
+
+		if check := writer.Code; check != http.StatusNotFound {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("parse typed application error without status error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", errorMapperTestNilStatus{})
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Code; check != http.StatusInternalServerError {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("parse sentinel mapped error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		mapper := NewErrorMapper()
+		_ = mapper.Register(ErrorMapping{Error: cache.ErrMiss, Status: http.StatusConflict, Code: 34, Message: "conflict"})
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), mapper, NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", fmt.Errorf("wrapped : %w", cache.ErrMiss))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:34","message":"conflict"}]}}`
+
+		if check := writer.Code; check != http.StatusConflict {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("parse panic error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).Return(nil).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic(fmt.Errorf("error message"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("panic non-error value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).Return(nil).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic("string message")
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("panic non-error value exposing the raw error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := ExposeErrors
+		ExposeErrors = true
+		defer func() { ExposeErrors = prev }()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).Return(nil).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic("string message")
+		})
+
+		gin.SetMode(gin.DebugMode)
+		defer gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error","raw":"string message"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("don't expose the raw error in release mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := ExposeErrors
+		ExposeErrors = true
+		defer func() { ExposeErrors = prev }()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).Return(nil).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic("string message")
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("log the panic and don't expose a panicking mapped error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).DoAndReturn(
+			func(_ string, _ log.Level, _ string, ctx log.Context) error {
+				switch {
+				case ctx["endpoint"] != endpoint:
+					t.Errorf("logged the (%v) endpoint", ctx["endpoint"])
+				case ctx["panic"] != "not found message":
+					t.Errorf("logged the (%v) panic value", ctx["panic"])
+				case !strings.Contains(ctx["stack"].(string), "runtime/debug.Stack"):
+					t.Errorf("logged the (%v) stack trace", ctx["stack"])
+				}
+				return nil
+			},
+		).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic(NewEnvelopeError(http.StatusNotFound, 10, "not found message"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Code; check != http.StatusInternalServerError {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("call the registered panic hooks", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogPanicMessage, gomock.Any()).Return(nil).Times(1)
+		var reported interface{}
+		var stack []byte
+		hooks := NewPanicHooks()
+		_ = hooks.Add(func(_ *gin.Context, value interface{}, trace []byte) {
+			reported = value
+			stack = trace
+		})
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), hooks, NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			panic("string message")
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		switch {
+		case reported != "string message":
+			t.Errorf("reported the (%v) panic value", reported)
+		case len(stack) == 0:
+			t.Error("didn't reported the stack trace")
+		case writer.Code != http.StatusInternalServerError:
+			t.Errorf("responded with the (%v) status code", writer.Code)
+		}
+	})
+
+	t.Run("error getting the endpoint config block", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(nil, expected).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, log.Context{"error": expected}).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, e := generator(endpoint)
+		switch {
+		case mw != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case e.Error() != expected.Error():
+			t.Errorf("returned the (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("invalid endpoint config block", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": "string"}, nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, gomock.Any()).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, e := generator(endpoint)
+		switch {
+		case mw != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrConversion):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid endpoint accept list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"accept": []interface{}{gin.MIMEXML, 123}}, nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogAcceptListErrorMessage, gomock.Any()).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, e := generator(endpoint)
+		switch {
+		case mw != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrInvalidAcceptList):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidAcceptList)
+		}
+	})
+
+	t.Run("endpoint accepted formats override the service accepted formats", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"accept": []interface{}{gin.MIMEXML}}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewEnvelope(http.StatusOK, "data"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{Header: http.Header{}}
+		ctx.Request.Header.Set("Accept", gin.MIMEJSON)
+		handler(ctx)
+
+		expected := `<envelope><status><success>false</success><error><error code="s:1.e:2.c:0" message="not acceptable"></error></error></status></envelope>`
+
+		if check := writer.Code; check != http.StatusNotAcceptable {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("assign the endpoint default status to responses without status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": http.StatusCreated}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewTypedEnvelope(0, "data"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":true,"error":[]},"data":"data"}`
+
+		if check := writer.Code; check != http.StatusCreated {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("don't envelope the endpoint success responses", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"envelope": false}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewEnvelope(http.StatusOK, map[string]string{"field": "value"}))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"field":"value"}`
+
+		if check := writer.Code; check != http.StatusOK {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("envelope the error responses of non enveloped endpoints", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"envelope": false}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewEnvelope(http.StatusNotFound, nil).AddError(envelope.NewStatusError(3, "not found")))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:3","message":"not found"}]}}`
+
+		if check := writer.Code; check != http.StatusNotFound {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("render the endpoint errors as problem details", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"problem": true}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewEnvelope(http.StatusNotFound, nil).AddError(envelope.NewStatusError(3, "not found")))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{Header: http.Header{}}
+		ctx.Request.Header.Set("Accept", gin.MIMEJSON)
+		handler(ctx)
+
+		if check := writer.Code; check != http.StatusNotFound {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Header().Get("Content-Type"); !strings.HasPrefix(check, envelope.MIMEProblemJSON) {
+			t.Errorf("responded with the (%v) content type", check)
+		}
+	})
+
+	t.Run("registered endpoint config observer discard an invalid accept list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback config.IObserver
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"accept": []interface{}{gin.MIMEXML}}, nil),
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"accept": []interface{}{gin.MIMEJSON, 123}}, nil),
+		)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+			cfgManager.
+				EXPECT().
+				AddObserver("slate.rest.endpoints.index", gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogAcceptListErrorMessage, gomock.Any()).Times(1)
+		inspector := NewInspector()
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), inspector)
+		_, _ = generator(endpoint)
+
+		callback(nil, nil)
+
+		if check, e := inspector.Config(endpoint); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if !reflect.DeepEqual(check.Accept, []string{gin.MIMEXML}) {
+			t.Errorf("inspected the (%v) accept list", check.Accept)
+		}
+	})
+
+	t.Run("registered endpoint config observer discard an out of range status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback config.IObserver
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": http.StatusCreated}, nil),
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": 600}, nil),
+		)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+			cfgManager.
+				EXPECT().
+				AddObserver("slate.rest.endpoints.index", gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, gomock.Any()).Times(1)
+		inspector := NewInspector()
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), inspector)
+		_, _ = generator(endpoint)
+
+		callback(nil, nil)
+
+		if check, e := inspector.Config(endpoint); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if check.Status != http.StatusCreated {
+			t.Errorf("inspected the (%v) status", check.Status)
+		}
+	})
+
+	t.Run("registered observer update the endpoint config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback config.IObserver
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil),
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": http.StatusAccepted}, nil),
+			cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": "string"}, nil),
+		)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+			cfgManager.
+				EXPECT().
+				AddObserver("slate.rest.endpoints.index", gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, gomock.Any()).Times(1)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewTypedEnvelope(0, "data"))
+		})
+
+		// update the endpoint config, and then try an invalid update
+		// that should keep the previous config
+		callback(nil, nil)
+		callback(nil, nil)
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		if check := writer.Code; check != http.StatusAccepted {
+			t.Errorf("responded with the (%v) status code", check)
+		}
+	})
+
+	t.Run("register the middleware effective config in the inspector", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{"status": http.StatusCreated}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		logger := NewMockLog(ctrl)
+		inspector := NewInspector()
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), inspector)
+		_, _ = generator(endpoint)
+
+		expected := &Config{
+			Endpoint:   endpoint,
+			ServiceID:  1,
+			EndpointID: 2,
+			Accept:     []string{gin.MIMEJSON},
+			Envelope:   true,
+			Status:     http.StatusCreated,
+			Problem:    ProblemDetails,
+		}
+
+		if check, e := inspector.Config(endpoint); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if !reflect.DeepEqual(check, expected) {
+			t.Errorf("inspected the (%v) config when expecting (%v)", check, expected)
+		}
+	})
+
+	t.Run("invalid observed updates keep the last good config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		callbacks := map[string]config.IObserver{}
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver(gomock.Any(), gomock.Any()).DoAndReturn(func(path string, cb config.IObserver) error {
+			callbacks[path] = cb
+			return nil
+		}).Times(4)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		inspector := NewInspector()
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), inspector)
+		_, _ = generator(endpoint)
+
+		callbacks[ServiceIDConfigPath](1, "string")
+		callbacks[FormatAcceptListConfigPath]([]interface{}{gin.MIMEJSON}, []interface{}{gin.MIMEXML, 123})
+		callbacks["slate.rest.endpoints.index.id"](2, "string")
+
+		check, _ := inspector.Config(endpoint)
+		switch {
+		case check.ServiceID != 1:
+			t.Errorf("inspected the (%v) service id", check.ServiceID)
+		case !reflect.DeepEqual(check.Accept, []string{gin.MIMEJSON}):
+			t.Errorf("inspected the (%v) accept list", check.Accept)
+		case check.EndpointID != 2:
+			t.Errorf("inspected the (%v) endpoint id", check.EndpointID)
+		}
+	})
+
+	t.Run("observed updates don't race with the requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		callbacks := map[string]config.IObserver{}
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).AnyTimes()
+		cfgManager.EXPECT().AddObserver(gomock.Any(), gomock.Any()).DoAndReturn(func(path string, cb config.IObserver) error {
+			callbacks[path] = cb
+			return nil
+		}).Times(4)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", envelope.NewEnvelope(http.StatusOK, "data"))
+		})
+
+		gin.SetMode(gin.ReleaseMode)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				callbacks[ServiceIDConfigPath](nil, i)
+				callbacks[FormatAcceptListConfigPath](nil, []interface{}{gin.MIMEJSON})
+				callbacks["slate.rest.endpoints.index.id"](nil, i)
+				callbacks["slate.rest.endpoints.index"](nil, nil)
+			}(i)
+			go func() {
+				defer wg.Done()
+				writer := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(writer)
+				ctx.Request = &http.Request{}
+				handler(ctx)
+				if writer.Code != http.StatusOK {
+					t.Errorf("responded with the (%v) status code", writer.Code)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("registered observer update the service id value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.
+				EXPECT().
+				AddObserver(ServiceIDConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", fmt.Errorf("error message"))
+		})
+
+		callback(1, 2)
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:2.e:2.c:0","message":"internal server error"}]}}`
+
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
+		}
+	})
+
+	t.Run("registered service id observer log on invalid new service id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		newValue := "string"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.
+				EXPECT().
+				AddObserver(ServiceIDConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, LogServiceErrorMessage, log.Context{"value": newValue})
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		_, _ = generator(endpoint)
+
+		callback(1, newValue)
+	})
+
+	t.Run("registered service id observer log on invalid new service id with environment defined channel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := LogChannel
+		LogChannel = "test"
+		defer func() { LogChannel = prev }()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		newValue := "string"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.
+				EXPECT().
+				AddObserver(ServiceIDConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal("test", log.ERROR, LogServiceErrorMessage, log.Context{"value": newValue})
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		_, _ = generator(endpoint)
+
+		callback(1, newValue)
+	})
+
+	t.Run("registered service id observer log on invalid new service id with environment defined level", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := LogLevel
+		LogLevel = log.LevelMapName[log.DEBUG]
+		defer func() { LogLevel = prev }()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		newValue := "string"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.
+				EXPECT().
+				AddObserver(ServiceIDConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogServiceErrorMessage, log.Context{"value": newValue})
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		_, _ = generator(endpoint)
+
+		callback(1, newValue)
+	})
+
+	t.Run("registered service id observer log on invalid new service id with environment defined message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		prev := LogServiceErrorMessage
+		LogServiceErrorMessage = "test"
+		defer func() { LogServiceErrorMessage = prev }()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		newValue := "string"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.
+				EXPECT().
+				AddObserver(ServiceIDConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"value": newValue})
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		_, _ = generator(endpoint)
+
+		callback(1, newValue)
+	})
+
+	t.Run("registered observer update the accept formats value", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var callback func(old interface{}, new interface{})
+
+		endpoint := "index"
+		cfgManager := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfgManager.EXPECT().Int(ServiceIDConfigPath, 0).Return(1, nil),
+			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
+		)
+		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
+		gomock.InOrder(
+			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
+			cfgManager.
+				EXPECT().
+				AddObserver(FormatAcceptListConfigPath, gomock.Any()).
+				DoAndReturn(func(id string, cb config.IObserver) error {
+					callback = cb
+					return nil
+				}),
+			cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index.id", gomock.Any()).Return(nil),
+		)
+		logger := NewMockLog(ctrl)
+
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
+		mw, _ := generator(endpoint)
+
+		handler := mw(func(ctx *gin.Context) {
+			ctx.Set("response", fmt.Errorf("error message"))
+		})
+
+		callback([]interface{}{gin.MIMEXML}, []interface{}{gin.MIMEJSON})
+
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = &http.Request{}
+		handler(ctx)
+
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
 
 		if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
@@ -946,6 +2304,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -960,7 +2320,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogAcceptListErrorMessage, log.Context{"list": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -984,6 +2344,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -998,7 +2360,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogAcceptListErrorMessage, log.Context{"list": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1022,6 +2384,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1036,7 +2400,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogAcceptListErrorMessage, log.Context{"list": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1060,6 +2424,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1074,7 +2440,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"list": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1095,6 +2461,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1109,7 +2477,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogAcceptListErrorMessage, log.Context{"value": invalidValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1134,6 +2502,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1148,7 +2518,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogAcceptListErrorMessage, log.Context{"value": invalidValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1173,6 +2543,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1187,7 +2559,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogAcceptListErrorMessage, log.Context{"value": invalidValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1212,6 +2584,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEXML}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.
@@ -1226,7 +2600,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"value": invalidValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback([]interface{}{gin.MIMEXML}, newValue)
@@ -1246,6 +2620,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -1259,7 +2635,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		)
 		logger := NewMockLog(ctrl)
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		mw, _ := generator(endpoint)
 
 		handler := mw(func(ctx *gin.Context) {
@@ -1274,7 +2650,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		ctx.Request = &http.Request{}
 		handler(ctx)
 
-		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:10.c:0","message":"error message"}]}}`
+		expected := `{"status":{"success":false,"error":[{"code":"s:1.e:10.c:0","message":"internal server error"}]}}`
 
 		if check := writer.Body.String(); check != expected {
 			t.Errorf("parsed (%v) response data when expecting : %v", check, expected)
@@ -1295,6 +2671,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -1309,7 +2687,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, LogEndpointErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback(2, newValue)
@@ -1333,6 +2711,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -1347,7 +2727,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal("test", log.ERROR, LogEndpointErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback(2, newValue)
@@ -1371,6 +2751,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -1385,7 +2767,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.DEBUG, LogEndpointErrorMessage, log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback(2, newValue)
@@ -1409,6 +2791,8 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 			cfgManager.EXPECT().Int("slate.rest.endpoints.index.id", 0).Return(2, nil),
 		)
 		cfgManager.EXPECT().List(FormatAcceptListConfigPath).Return([]interface{}{gin.MIMEJSON}, nil).Times(1)
+		cfgManager.EXPECT().Config("slate.rest.endpoints.index", gomock.Any()).Return(config.Config{}, nil).Times(1)
+		cfgManager.EXPECT().AddObserver("slate.rest.endpoints.index", gomock.Any()).Return(nil).Times(1)
 		gomock.InOrder(
 			cfgManager.EXPECT().AddObserver(ServiceIDConfigPath, gomock.Any()).Return(nil),
 			cfgManager.EXPECT().AddObserver(FormatAcceptListConfigPath, gomock.Any()).Return(nil),
@@ -1423,7 +2807,7 @@ func Test_NewMiddlewareGenerator(t *testing.T) {
 		logger := NewMockLog(ctrl)
 		logger.EXPECT().Signal(LogChannel, log.ERROR, "test", log.Context{"value": newValue})
 
-		generator, _ := NewMiddlewareGenerator(cfgManager, logger)
+		generator, _ := NewMiddlewareGenerator(cfgManager, logger, newRendererRegistry(), NewErrorMapper(), NewPanicHooks(), NewInspector())
 		_, _ = generator(endpoint)
 
 		callback(2, newValue)
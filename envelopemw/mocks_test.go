@@ -3,6 +3,7 @@ package envelopemw
 import (
 	"reflect"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/happyhippyhippo/slate/config"
 	"github.com/happyhippyhippo/slate/log"
@@ -526,3 +527,60 @@ func (mr *MockLogRecorder) Stream(id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockLog)(nil).Stream), id)
 }
+
+//------------------------------------------------------------------------------
+// Renderer
+//------------------------------------------------------------------------------
+
+// MockRenderer is a mock an instance of IRenderer interface.
+type MockRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRendererRecorder
+}
+
+var _ IRenderer = &MockRenderer{}
+
+// MockRendererRecorder is the mock recorder for MockRenderer.
+type MockRendererRecorder struct {
+	mock *MockRenderer
+}
+
+// NewMockRenderer creates a new mock instance.
+func NewMockRenderer(ctrl *gomock.Controller) *MockRenderer {
+	mock := &MockRenderer{ctrl: ctrl}
+	mock.recorder = &MockRendererRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenderer) EXPECT() *MockRendererRecorder {
+	return m.recorder
+}
+
+// Mimes mocks base method.
+func (m *MockRenderer) Mimes() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mimes")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Mimes indicates an expected call of Mimes.
+func (mr *MockRendererRecorder) Mimes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mimes", reflect.TypeOf((*MockRenderer)(nil).Mimes))
+}
+
+// Render mocks base method.
+func (m *MockRenderer) Render(ctx *gin.Context, mime string, status int, data interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, mime, status, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockRendererRecorder) Render(ctx, mime, status, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockRenderer)(nil).Render), ctx, mime, status, data)
+}
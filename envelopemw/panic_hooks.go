@@ -0,0 +1,59 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// PanicHook defines a function called with the information of a panic
+// recovered by the envelope middleware, mostly used to report the panic
+// to an error tracking service.
+type PanicHook func(ctx *gin.Context, value interface{}, stack []byte)
+
+// IPanicHooks defines the interface of a panic hook list instance.
+type IPanicHooks interface {
+	Add(hook PanicHook) error
+	Call(ctx *gin.Context, value interface{}, stack []byte)
+}
+
+// PanicHooks defines a list of panic hooks called in the order of
+// their registration.
+type PanicHooks struct {
+	hooks []PanicHook
+}
+
+var _ IPanicHooks = &PanicHooks{}
+
+// NewPanicHooks will instantiate a new empty panic hook list.
+func NewPanicHooks() IPanicHooks {
+	return &PanicHooks{
+		hooks: []PanicHook{},
+	}
+}
+
+// Add will append a new hook to the panic hook list.
+func (h *PanicHooks) Add(
+	hook PanicHook,
+) error {
+	// check the hook argument reference
+	if hook == nil {
+		return errNilPointer("hook")
+	}
+	h.hooks = append(h.hooks, hook)
+	return nil
+}
+
+// Call will call all the registered hooks with the given panic
+// information. A panicking hook will not prevent the remaining
+// hooks from being called.
+func (h PanicHooks) Call(
+	ctx *gin.Context,
+	value interface{},
+	stack []byte,
+) {
+	for _, hook := range h.hooks {
+		func() {
+			defer func() { _ = recover() }()
+			hook(ctx, value, stack)
+		}()
+	}
+}
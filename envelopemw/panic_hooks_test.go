@@ -0,0 +1,72 @@
+package envelopemw
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewPanicHooks(t *testing.T) {
+	t.Run("new panic hook list", func(t *testing.T) {
+		if sut := NewPanicHooks(); sut == nil {
+			t.Error("didn't returned a valid reference")
+		}
+	})
+}
+
+func Test_PanicHooks_Add(t *testing.T) {
+	t.Run("nil hook", func(t *testing.T) {
+		if e := NewPanicHooks().Add(nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("add hook", func(t *testing.T) {
+		sut := NewPanicHooks()
+		if e := sut.Add(func(*gin.Context, interface{}, []byte) {}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if check := len(sut.(*PanicHooks).hooks); check != 1 {
+			t.Errorf("stored (%v) hooks", check)
+		}
+	})
+}
+
+func Test_PanicHooks_Call(t *testing.T) {
+	t.Run("call the hooks in registration order", func(t *testing.T) {
+		var calls []string
+		sut := NewPanicHooks()
+		_ = sut.Add(func(_ *gin.Context, value interface{}, _ []byte) {
+			calls = append(calls, "first:"+value.(string))
+		})
+		_ = sut.Add(func(_ *gin.Context, value interface{}, _ []byte) {
+			calls = append(calls, "second:"+value.(string))
+		})
+
+		sut.Call(nil, "value", []byte("stack"))
+
+		if len(calls) != 2 || calls[0] != "first:value" || calls[1] != "second:value" {
+			t.Errorf("called the hooks as (%v)", calls)
+		}
+	})
+
+	t.Run("panicking hook don't prevent the remaining calls", func(t *testing.T) {
+		called := false
+		sut := NewPanicHooks()
+		_ = sut.Add(func(*gin.Context, interface{}, []byte) {
+			panic("hook panic")
+		})
+		_ = sut.Add(func(*gin.Context, interface{}, []byte) {
+			called = true
+		})
+
+		sut.Call(nil, "value", []byte("stack"))
+
+		if !called {
+			t.Error("didn't called the hook after the panicking hook")
+		}
+	})
+}
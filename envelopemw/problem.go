@@ -0,0 +1,86 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// problemFormat will negotiate the RFC 7807 problem details format of
+// the given error envelope. The problem details are selected if requested
+// by the client, through content negotiation, or if enforced by the
+// environment for all error responses. Batch envelopes are never rendered
+// as problem details, so the items data and statuses aren't lost. An
+// empty string is returned if the response should not be rendered as a
+// problem details.
+func problemFormat(
+	ctx *gin.Context,
+	response *envelope.Envelope,
+	accepted []string,
+	enforce bool,
+) string {
+	// only non-batch error envelopes can be rendered as problem details
+	if response.Status == nil || response.Status.Success || response.IsBatch() {
+		return ""
+	}
+	// negotiate the response format with the problem details formats
+	// offered after the service accepted formats
+	offered := append(append([]string{}, accepted...), envelope.MIMEProblemJSON, envelope.MIMEProblemXML)
+	format := ctx.NegotiateFormat(offered...)
+	// map the negotiated format to the problem details equivalent
+	// if enforced by the endpoint configuration
+	if enforce {
+		switch format {
+		case gin.MIMEJSON:
+			format = envelope.MIMEProblemJSON
+		case gin.MIMEXML, gin.MIMEXML2:
+			format = envelope.MIMEProblemXML
+		}
+	}
+	// check if the negotiated format is a problem details format
+	if format != envelope.MIMEProblemJSON && format != envelope.MIMEProblemXML {
+		return ""
+	}
+	return format
+}
+
+// problemEquivalentFormat will negotiate the problem details formats for
+// the responses that can't be rendered as problem details, like the batch
+// envelopes, and map the negotiated format to its plain equivalent if
+// offered by the endpoint. An empty string is returned if the client
+// didn't request a problem details format with an offered equivalent.
+func problemEquivalentFormat(
+	ctx *gin.Context,
+	offered []string,
+) string {
+	// map the negotiated problem details format to its plain equivalent
+	var format string
+	switch ctx.NegotiateFormat(envelope.MIMEProblemJSON, envelope.MIMEProblemXML) {
+	case envelope.MIMEProblemJSON:
+		format = gin.MIMEJSON
+	case envelope.MIMEProblemXML:
+		format = gin.MIMEXML
+	default:
+		return ""
+	}
+	// check if the equivalent format is offered by the endpoint
+	for _, mime := range offered {
+		if mime == format {
+			return format
+		}
+	}
+	return ""
+}
+
+// newProblem will compose the problem details structure of the
+// given error envelope.
+func newProblem(
+	ctx *gin.Context,
+	response *envelope.Envelope,
+) *envelope.Problem {
+	problem, _ := envelope.NewProblem(response)
+	problem.SetType(ProblemType)
+	if ctx.Request != nil && ctx.Request.URL != nil {
+		problem.SetInstance(ctx.Request.URL.RequestURI())
+	}
+	return problem
+}
@@ -0,0 +1,119 @@
+package envelopemw
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func Test_problemFormat(t *testing.T) {
+	newContext := func(accept string) *gin.Context {
+		gin.SetMode(gin.ReleaseMode)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource", nil)
+		if accept != "" {
+			ctx.Request.Header.Set("Accept", accept)
+		}
+		return ctx
+	}
+	errorEnvelope := envelope.NewEnvelope(http.StatusBadRequest, nil).AddError(envelope.NewStatusError(1, "error message"))
+
+	t.Run("don't select for success envelopes", func(t *testing.T) {
+		ctx := newContext(envelope.MIMEProblemJSON)
+
+		if check := problemFormat(ctx, envelope.NewEnvelope(http.StatusOK, nil), []string{gin.MIMEJSON}, false); check != "" {
+			t.Errorf("selected the (%v) format for a success envelope", check)
+		}
+	})
+
+	t.Run("don't select for batch envelopes", func(t *testing.T) {
+		batch := envelope.NewBatchEnvelope(
+			envelope.NewBatchItem("1", http.StatusCreated, nil),
+			envelope.NewBatchItem("2", http.StatusConflict, nil).AddError(envelope.NewStatusError(3, "conflict")),
+		)
+
+		for _, enforce := range []bool{false, true} {
+			ctx := newContext(envelope.MIMEProblemJSON)
+
+			if check := problemFormat(ctx, batch, []string{gin.MIMEJSON}, enforce); check != "" {
+				t.Errorf("selected the (%v) format for a batch envelope", check)
+			}
+		}
+	})
+
+	t.Run("select by content negotiation", func(t *testing.T) {
+		scenarios := []struct {
+			accept   string
+			expected string
+		}{
+			{accept: "", expected: ""},
+			{accept: "*/*", expected: ""},
+			{accept: gin.MIMEJSON, expected: ""},
+			{accept: envelope.MIMEProblemJSON, expected: envelope.MIMEProblemJSON},
+			{accept: envelope.MIMEProblemXML, expected: envelope.MIMEProblemXML},
+			{accept: envelope.MIMEProblemXML + ", " + gin.MIMEJSON, expected: envelope.MIMEProblemXML},
+		}
+
+		for _, scenario := range scenarios {
+			ctx := newContext(scenario.accept)
+
+			if check := problemFormat(ctx, errorEnvelope, []string{gin.MIMEJSON, gin.MIMEXML}, false); check != scenario.expected {
+				t.Errorf("selected the (%v) format for (%v) when expecting (%v)", check, scenario.accept, scenario.expected)
+			}
+		}
+	})
+
+	t.Run("select if enforced", func(t *testing.T) {
+		scenarios := []struct {
+			accept   string
+			expected string
+		}{
+			{accept: gin.MIMEJSON, expected: envelope.MIMEProblemJSON},
+			{accept: gin.MIMEXML, expected: envelope.MIMEProblemXML},
+			{accept: gin.MIMEXML2, expected: envelope.MIMEProblemXML},
+			{accept: gin.MIMEYAML, expected: ""},
+		}
+
+		for _, scenario := range scenarios {
+			ctx := newContext(scenario.accept)
+			accepted := []string{gin.MIMEJSON, gin.MIMEXML, gin.MIMEXML2, gin.MIMEYAML}
+
+			if check := problemFormat(ctx, errorEnvelope, accepted, true); check != scenario.expected {
+				t.Errorf("selected the (%v) format for (%v) when expecting (%v)", check, scenario.accept, scenario.expected)
+			}
+		}
+	})
+}
+
+func Test_newProblem(t *testing.T) {
+	t.Run("compose without request url", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = &http.Request{}
+
+		problem := newProblem(ctx, envelope.NewEnvelope(http.StatusBadRequest, nil))
+		if problem.Instance != "" {
+			t.Errorf("stored the unexpected (%v) instance", problem.Instance)
+		} else if problem.Type != envelope.ProblemBlankType {
+			t.Errorf("stored the (%v) type", problem.Type)
+		}
+	})
+
+	t.Run("compose with environment defined type", func(t *testing.T) {
+		prev := ProblemType
+		ProblemType = "https://domain.com/problem"
+		defer func() { ProblemType = prev }()
+
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource?id=1", nil)
+
+		problem := newProblem(ctx, envelope.NewEnvelope(http.StatusBadRequest, nil))
+		if problem.Instance != "/resource?id=1" {
+			t.Errorf("stored the (%v) instance", problem.Instance)
+		} else if problem.Type != "https://domain.com/problem" {
+			t.Errorf("stored the (%v) type", problem.Type)
+		}
+	})
+}
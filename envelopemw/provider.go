@@ -1,6 +1,7 @@
 package envelopemw
 
 import (
+	"github.com/gin-gonic/gin"
 	"github.com/happyhippyhippo/slate"
 	"github.com/happyhippyhippo/slate-rest"
 )
@@ -9,6 +10,51 @@ const (
 	// ID defines the default id used to register
 	// the application envelope middleware and related services.
 	ID = rest.ID + ".envelope"
+
+	// RendererTag defines the tag to be assigned to all
+	// container response renderers.
+	RendererTag = ID + ".renderer"
+
+	// RendererJSONID defines the id to be used as the
+	// container registration id of the JSON response renderer.
+	RendererJSONID = ID + ".renderer.json"
+
+	// RendererXMLID defines the id to be used as the
+	// container registration id of the XML response renderer.
+	RendererXMLID = ID + ".renderer.xml"
+
+	// RendererYAMLID defines the id to be used as the
+	// container registration id of the YAML response renderer.
+	RendererYAMLID = ID + ".renderer.yaml"
+
+	// RendererMsgPackID defines the id to be used as the
+	// container registration id of the MessagePack response renderer.
+	RendererMsgPackID = ID + ".renderer.msgpack"
+
+	// RendererRegistryID defines the id to be used as the
+	// container registration id of the response renderer registry.
+	RendererRegistryID = ID + ".renderer.registry"
+
+	// ErrorMappingTag defines the tag to be assigned to all
+	// container error mappings.
+	ErrorMappingTag = ID + ".error.mapping"
+
+	// ErrorMapperID defines the id to be used as the
+	// container registration id of the error mapper.
+	ErrorMapperID = ID + ".error.mapper"
+
+	// PanicHookTag defines the tag to be assigned to all
+	// container panic hooks, as PanicHook values or as plain
+	// func(*gin.Context, interface{}, []byte) values.
+	PanicHookTag = ID + ".panic.hook"
+
+	// PanicHooksID defines the id to be used as the
+	// container registration id of the panic hook list.
+	PanicHooksID = ID + ".panic.hooks"
+
+	// InspectorID defines the id to be used as the container
+	// registration id of the generated middlewares inspector.
+	InspectorID = ID + ".inspector"
 )
 
 // Provider defines the default envelope provider to be used on
@@ -25,18 +71,188 @@ func (p Provider) Register(
 	if len(container) == 0 || container[0] == nil {
 		return errNilPointer("container")
 	}
+	// register the response renderers and registry
+	_ = container[0].Service(RendererJSONID, NewRendererJSON, RendererTag)
+	_ = container[0].Service(RendererXMLID, NewRendererXML, RendererTag)
+	_ = container[0].Service(RendererYAMLID, NewRendererYAML, RendererTag)
+	_ = container[0].Service(RendererMsgPackID, NewRendererMsgPack, RendererTag)
+	_ = container[0].Service(RendererRegistryID, NewRendererRegistry)
+	// register the error mapper
+	_ = container[0].Service(ErrorMapperID, NewErrorMapper)
+	// register the panic hook list
+	_ = container[0].Service(PanicHooksID, NewPanicHooks)
+	// register the generated middlewares inspector
+	_ = container[0].Service(InspectorID, NewInspector)
 	// register the envelope middleware generator
 	_ = container[0].Service(ID, NewMiddlewareGenerator)
 	return nil
 }
 
-// Boot (no-op).
-func (Provider) Boot(
+// Boot will populate the renderer registry with all the
+// registered response renderers, the error mapper with all the
+// registered error mappings and the panic hook list with all the
+// registered panic hooks.
+func (p Provider) Boot(
 	container ...slate.IContainer,
 ) error {
 	// check container argument reference
 	if len(container) == 0 || container[0] == nil {
 		return errNilPointer("container")
 	}
+	// populate the container renderer registry with
+	// all registered renderers
+	registry, e := p.getRendererRegistry(container[0])
+	if e != nil {
+		return e
+	}
+	renderers, e := p.getRenderers(container[0])
+	if e != nil {
+		return e
+	}
+	for _, renderer := range renderers {
+		_ = registry.Register(renderer)
+	}
+	// populate the container error mapper with
+	// all registered error mappings
+	mapper, e := p.getErrorMapper(container[0])
+	if e != nil {
+		return e
+	}
+	mappings, e := p.getErrorMappings(container[0])
+	if e != nil {
+		return e
+	}
+	for _, mapping := range mappings {
+		if e := mapper.Register(mapping); e != nil {
+			return e
+		}
+	}
+	// populate the container panic hook list with
+	// all registered panic hooks
+	hookList, e := p.getPanicHooks(container[0])
+	if e != nil {
+		return e
+	}
+	hooks, e := p.getPanicHookEntries(container[0])
+	if e != nil {
+		return e
+	}
+	for _, hook := range hooks {
+		_ = hookList.Add(hook)
+	}
 	return nil
 }
+
+func (Provider) getRendererRegistry(
+	container slate.IContainer,
+) (IRendererRegistry, error) {
+	// retrieve the registry entry
+	entry, e := container.Get(RendererRegistryID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(IRendererRegistry)
+	if !ok {
+		return nil, errConversion(entry, "envelopemw.IRendererRegistry")
+	}
+	return instance, nil
+}
+
+func (Provider) getRenderers(
+	container slate.IContainer,
+) ([]IRenderer, error) {
+	// retrieve the renderers entries
+	entries, e := container.Tag(RendererTag)
+	if e != nil {
+		return nil, e
+	}
+	// type check the retrieved renderers
+	var renderers []IRenderer
+	for _, entry := range entries {
+		switch instance := entry.(type) {
+		case IRenderer:
+			renderers = append(renderers, instance)
+		default:
+			return nil, errConversion(entry, "envelopemw.IRenderer")
+		}
+	}
+	return renderers, nil
+}
+
+func (Provider) getErrorMapper(
+	container slate.IContainer,
+) (IErrorMapper, error) {
+	// retrieve the mapper entry
+	entry, e := container.Get(ErrorMapperID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(IErrorMapper)
+	if !ok {
+		return nil, errConversion(entry, "envelopemw.IErrorMapper")
+	}
+	return instance, nil
+}
+
+func (Provider) getErrorMappings(
+	container slate.IContainer,
+) ([]ErrorMapping, error) {
+	// retrieve the mappings entries
+	entries, e := container.Tag(ErrorMappingTag)
+	if e != nil {
+		return nil, e
+	}
+	// type check the retrieved mappings
+	var mappings []ErrorMapping
+	for _, entry := range entries {
+		switch instance := entry.(type) {
+		case ErrorMapping:
+			mappings = append(mappings, instance)
+		default:
+			return nil, errConversion(entry, "envelopemw.ErrorMapping")
+		}
+	}
+	return mappings, nil
+}
+
+func (Provider) getPanicHooks(
+	container slate.IContainer,
+) (IPanicHooks, error) {
+	// retrieve the hook list entry
+	entry, e := container.Get(PanicHooksID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(IPanicHooks)
+	if !ok {
+		return nil, errConversion(entry, "envelopemw.IPanicHooks")
+	}
+	return instance, nil
+}
+
+func (Provider) getPanicHookEntries(
+	container slate.IContainer,
+) ([]PanicHook, error) {
+	// retrieve the hooks entries
+	entries, e := container.Tag(PanicHookTag)
+	if e != nil {
+		return nil, e
+	}
+	// type check the retrieved hooks, accepting the plain hook
+	// function signature as well as the named hook type
+	var hooks []PanicHook
+	for _, entry := range entries {
+		switch instance := entry.(type) {
+		case PanicHook:
+			hooks = append(hooks, instance)
+		case func(*gin.Context, interface{}, []byte):
+			hooks = append(hooks, instance)
+		default:
+			return nil, errConversion(entry, "envelopemw.PanicHook")
+		}
+	}
+	return hooks, nil
+}
@@ -3,6 +3,7 @@ package envelopemw
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -39,6 +40,22 @@ func Test_Provider_Register(t *testing.T) {
 			t.Errorf("returned the (%v) error", e)
 		case !container.Has(ID):
 			t.Errorf("didn't registered the generator : %v", sut)
+		case !container.Has(RendererRegistryID):
+			t.Errorf("didn't registered the renderer registry : %v", sut)
+		case !container.Has(RendererJSONID):
+			t.Errorf("didn't registered the JSON renderer : %v", sut)
+		case !container.Has(RendererXMLID):
+			t.Errorf("didn't registered the XML renderer : %v", sut)
+		case !container.Has(RendererYAMLID):
+			t.Errorf("didn't registered the YAML renderer : %v", sut)
+		case !container.Has(RendererMsgPackID):
+			t.Errorf("didn't registered the MessagePack renderer : %v", sut)
+		case !container.Has(ErrorMapperID):
+			t.Errorf("didn't registered the error mapper : %v", sut)
+		case !container.Has(PanicHooksID):
+			t.Errorf("didn't registered the panic hook list : %v", sut)
+		case !container.Has(InspectorID):
+			t.Errorf("didn't registered the middleware inspector : %v", sut)
 		}
 	})
 
@@ -122,6 +139,237 @@ func Test_Provider_Boot(t *testing.T) {
 		}
 	})
 
+	t.Run("error retrieving renderer registry", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(RendererRegistryID, func() (IRendererRegistry, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid renderer registry", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(RendererRegistryID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving renderer", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() (IRenderer, error) { return nil, expected }, RendererTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid renderer", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() string { return "string" }, RendererTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving error mapper", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(ErrorMapperID, func() (IErrorMapper, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid error mapper", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(ErrorMapperID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving error mapping", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() (ErrorMapping, error) { return ErrorMapping{}, expected }, ErrorMappingTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid error mapping entry", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() string { return "string" }, ErrorMappingTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid error mapping", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() ErrorMapping { return ErrorMapping{} }, ErrorMappingTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("populate the error mapper", func(t *testing.T) {
+		sentinel := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() ErrorMapping {
+			return ErrorMapping{Error: sentinel, Status: http.StatusNotFound}
+		}, ErrorMappingTag)
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else if mapper, _ := sut.getErrorMapper(container); mapper == nil {
+			t.Error("didn't retrieved the error mapper")
+		} else if check := mapper.Map(sentinel); check == nil {
+			t.Error("didn't registered the tagged error mapping")
+		} else if check.GetStatusCode() != http.StatusNotFound {
+			t.Errorf("registered the mapping with the (%v) status", check.GetStatusCode())
+		}
+	})
+
+	t.Run("error retrieving panic hook list", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(PanicHooksID, func() (IPanicHooks, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid panic hook list", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service(PanicHooksID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving panic hook", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() (PanicHook, error) { return nil, expected }, PanicHookTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid panic hook", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() string { return "string" }, PanicHookTag)
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("populate the panic hook list with plain hook functions", func(t *testing.T) {
+		called := false
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() func(*gin.Context, interface{}, []byte) {
+			return func(*gin.Context, interface{}, []byte) { called = true }
+		}, PanicHookTag)
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else if hooks, _ := sut.getPanicHooks(container); hooks == nil {
+			t.Error("didn't retrieved the panic hook list")
+		} else if hooks.Call(nil, "value", nil); !called {
+			t.Error("didn't registered the tagged panic hook")
+		}
+	})
+
+	t.Run("populate the panic hook list", func(t *testing.T) {
+		called := false
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() PanicHook {
+			return func(*gin.Context, interface{}, []byte) { called = true }
+		}, PanicHookTag)
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else if hooks, _ := sut.getPanicHooks(container); hooks == nil {
+			t.Error("didn't retrieved the panic hook list")
+		} else if hooks.Call(nil, "value", nil); !called {
+			t.Error("didn't registered the tagged panic hook")
+		}
+	})
+
 	t.Run("successful boot", func(t *testing.T) {
 		app := slate.NewApplication()
 		_ = app.Provide(Provider{})
@@ -130,4 +378,26 @@ func Test_Provider_Boot(t *testing.T) {
 			t.Errorf("returned the (%v) error", e)
 		}
 	})
+
+	t.Run("populate the renderer registry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		renderer := NewMockRenderer(ctrl)
+		renderer.EXPECT().Mimes().Return([]string{"application/csv"}).Times(1)
+		container := slate.NewContainer()
+		sut := &Provider{}
+		_ = sut.Register(container)
+		_ = container.Service("id", func() IRenderer { return renderer }, RendererTag)
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else if registry, _ := sut.getRendererRegistry(container); registry == nil {
+			t.Error("didn't retrieved the renderer registry")
+		} else if check, _ := registry.Get("application/csv"); check != renderer {
+			t.Error("didn't registered the tagged renderer")
+		} else if check, _ := registry.Get(gin.MIMEJSON); check == nil {
+			t.Error("didn't registered the JSON renderer")
+		}
+	})
 }
@@ -0,0 +1,12 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// IRenderer defines the interface of a response renderer that can
+// write a response content in one or more mime type formats.
+type IRenderer interface {
+	Mimes() []string
+	Render(ctx *gin.Context, mime string, status int, data interface{}) error
+}
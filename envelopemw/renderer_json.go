@@ -0,0 +1,39 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// RendererJSON defines a renderer that writes the response content
+// in JSON format.
+type RendererJSON struct{}
+
+var _ IRenderer = &RendererJSON{}
+
+// NewRendererJSON will instantiate a new JSON response renderer.
+func NewRendererJSON() *RendererJSON {
+	return &RendererJSON{}
+}
+
+// Mimes retrieves the list of the JSON mime types.
+func (RendererJSON) Mimes() []string {
+	return []string{gin.MIMEJSON, envelope.MIMEProblemJSON}
+}
+
+// Render writes the given data as the response content in JSON format.
+func (RendererJSON) Render(
+	ctx *gin.Context,
+	mime string,
+	status int,
+	data interface{},
+) error {
+	// check the context argument reference
+	if ctx == nil {
+		return errNilPointer("ctx")
+	}
+	// write the response with the requested mime type
+	ctx.Header("Content-Type", mime+"; charset=utf-8")
+	ctx.JSON(status, data)
+	return nil
+}
@@ -0,0 +1,58 @@
+package envelopemw
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func Test_RendererJSON_Mimes(t *testing.T) {
+	t.Run("retrieve the json mime types", func(t *testing.T) {
+		expected := []string{gin.MIMEJSON, envelope.MIMEProblemJSON}
+
+		if check := NewRendererJSON().Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime types when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_RendererJSON_Render(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if e := NewRendererJSON().Render(nil, gin.MIMEJSON, http.StatusOK, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("render the data", func(t *testing.T) {
+		scenarios := []struct {
+			mime        string
+			contentType string
+		}{
+			{mime: gin.MIMEJSON, contentType: "application/json; charset=utf-8"},
+			{mime: envelope.MIMEProblemJSON, contentType: "application/problem+json; charset=utf-8"},
+		}
+
+		for _, scenario := range scenarios {
+			writer := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(writer)
+			expected := `{"status":{"success":true,"error":[]},"data":"data"}`
+
+			switch e := NewRendererJSON().Render(ctx, scenario.mime, http.StatusCreated, envelope.NewEnvelope(http.StatusCreated, "data")); {
+			case e != nil:
+				t.Errorf("returned the unexpected error (%v)", e)
+			case writer.Code != http.StatusCreated:
+				t.Errorf("written the (%v) status code", writer.Code)
+			case writer.Header().Get("Content-Type") != scenario.contentType:
+				t.Errorf("written the (%v) content type when expecting (%v)", writer.Header().Get("Content-Type"), scenario.contentType)
+			case writer.Body.String() != expected:
+				t.Errorf("written the (%v) body when expecting (%v)", writer.Body.String(), expected)
+			}
+		}
+	})
+}
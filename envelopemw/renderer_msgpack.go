@@ -0,0 +1,41 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gin-gonic/gin/render"
+)
+
+// RendererMsgPack defines a renderer that writes the response content
+// in MessagePack format.
+type RendererMsgPack struct{}
+
+var _ IRenderer = &RendererMsgPack{}
+
+// NewRendererMsgPack will instantiate a new MessagePack response renderer.
+func NewRendererMsgPack() *RendererMsgPack {
+	return &RendererMsgPack{}
+}
+
+// Mimes retrieves the list of the MessagePack mime types.
+func (RendererMsgPack) Mimes() []string {
+	return []string{binding.MIMEMSGPACK, binding.MIMEMSGPACK2}
+}
+
+// Render writes the given data as the response content in
+// MessagePack format.
+func (RendererMsgPack) Render(
+	ctx *gin.Context,
+	mime string,
+	status int,
+	data interface{},
+) error {
+	// check the context argument reference
+	if ctx == nil {
+		return errNilPointer("ctx")
+	}
+	// write the response with the requested mime type
+	ctx.Header("Content-Type", mime)
+	ctx.Render(status, render.MsgPack{Data: data})
+	return nil
+}
@@ -0,0 +1,52 @@
+package envelopemw
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_RendererMsgPack_Mimes(t *testing.T) {
+	t.Run("retrieve the messagepack mime types", func(t *testing.T) {
+		expected := []string{binding.MIMEMSGPACK, binding.MIMEMSGPACK2}
+
+		if check := NewRendererMsgPack().Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime types when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_RendererMsgPack_Render(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if e := NewRendererMsgPack().Render(nil, binding.MIMEMSGPACK, http.StatusOK, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("render the data", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		data := map[string]string{"field": "value"}
+
+		e := NewRendererMsgPack().Render(ctx, binding.MIMEMSGPACK2, http.StatusCreated, data)
+		check := map[string]string{}
+		_ = binding.MsgPack.BindBody(writer.Body.Bytes(), &check)
+
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case writer.Code != http.StatusCreated:
+			t.Errorf("written the (%v) status code", writer.Code)
+		case writer.Header().Get("Content-Type") != binding.MIMEMSGPACK2:
+			t.Errorf("written the (%v) content type", writer.Header().Get("Content-Type"))
+		case !reflect.DeepEqual(check, data):
+			t.Errorf("written the (%v) data when expecting (%v)", check, data)
+		}
+	})
+}
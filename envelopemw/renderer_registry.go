@@ -0,0 +1,63 @@
+package envelopemw
+
+// IRendererRegistry defines the interface of a renderer registry instance.
+type IRendererRegistry interface {
+	Register(renderer IRenderer) error
+	Mimes() []string
+	Get(mime string) (IRenderer, error)
+}
+
+// RendererRegistry is a response renderer pool keyed by the
+// mime types that the registered renderers are able to render.
+type RendererRegistry struct {
+	mimes     []string
+	renderers map[string]IRenderer
+}
+
+var _ IRendererRegistry = &RendererRegistry{}
+
+// NewRendererRegistry will instantiate a new empty renderer registry.
+func NewRendererRegistry() IRendererRegistry {
+	return &RendererRegistry{
+		mimes:     []string{},
+		renderers: map[string]IRenderer{},
+	}
+}
+
+// Register will register a renderer for all the mime types that it
+// declares to be able to render. A previously registered renderer of
+// a mime type will be overridden.
+func (r *RendererRegistry) Register(
+	renderer IRenderer,
+) error {
+	// check the renderer argument reference
+	if renderer == nil {
+		return errNilPointer("renderer")
+	}
+	// store the renderer for all of its mime types
+	for _, mime := range renderer.Mimes() {
+		if _, ok := r.renderers[mime]; !ok {
+			r.mimes = append(r.mimes, mime)
+		}
+		r.renderers[mime] = renderer
+	}
+	return nil
+}
+
+// Mimes will retrieve the list of mime types with a registered renderer
+// in the order of their registration.
+func (r RendererRegistry) Mimes() []string {
+	return append([]string{}, r.mimes...)
+}
+
+// Get will retrieve the renderer registered for the requested mime type.
+func (r RendererRegistry) Get(
+	mime string,
+) (IRenderer, error) {
+	// search for the requested mime type renderer
+	renderer, ok := r.renderers[mime]
+	if !ok {
+		return nil, errRendererNotFound(mime)
+	}
+	return renderer, nil
+}
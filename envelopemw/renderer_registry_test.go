@@ -0,0 +1,88 @@
+package envelopemw
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func Test_NewRendererRegistry(t *testing.T) {
+	t.Run("construct empty registry", func(t *testing.T) {
+		if registry := NewRendererRegistry(); registry == nil {
+			t.Error("didn't returned a valid reference")
+		} else if check := registry.Mimes(); len(check) != 0 {
+			t.Errorf("returned the unexpected (%v) mime list", check)
+		}
+	})
+}
+
+func Test_RendererRegistry_Register(t *testing.T) {
+	t.Run("nil renderer", func(t *testing.T) {
+		if e := NewRendererRegistry().Register(nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("register renderer mime types", func(t *testing.T) {
+		registry := NewRendererRegistry()
+		_ = registry.Register(NewRendererJSON())
+		_ = registry.Register(NewRendererYAML())
+		expected := []string{gin.MIMEJSON, envelope.MIMEProblemJSON, gin.MIMEYAML}
+
+		if check := registry.Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime list when expecting (%v)", check, expected)
+		}
+	})
+
+	t.Run("override previously registered mime types", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		renderer := NewMockRenderer(ctrl)
+		renderer.EXPECT().Mimes().Return([]string{gin.MIMEJSON}).Times(1)
+		registry := NewRendererRegistry()
+		_ = registry.Register(NewRendererJSON())
+		_ = registry.Register(NewRendererYAML())
+		_ = registry.Register(renderer)
+		expected := []string{gin.MIMEJSON, envelope.MIMEProblemJSON, gin.MIMEYAML}
+
+		if check := registry.Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime list when expecting (%v)", check, expected)
+		} else if check, _ := registry.Get(gin.MIMEJSON); check != renderer {
+			t.Errorf("didn't override the previously registered renderer")
+		}
+	})
+}
+
+func Test_RendererRegistry_Get(t *testing.T) {
+	t.Run("renderer not found", func(t *testing.T) {
+		registry := NewRendererRegistry()
+		_ = registry.Register(NewRendererJSON())
+
+		if check, e := registry.Get(gin.MIMEXML); check != nil {
+			t.Errorf("returned the unexpected (%v) renderer", check)
+		} else if !errors.Is(e, ErrRendererNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrRendererNotFound)
+		}
+	})
+
+	t.Run("retrieve registered renderer", func(t *testing.T) {
+		registry := NewRendererRegistry()
+		renderer := NewRendererXML()
+		_ = registry.Register(NewRendererJSON())
+		_ = registry.Register(renderer)
+
+		for _, mime := range renderer.Mimes() {
+			if check, e := registry.Get(mime); e != nil {
+				t.Errorf("returned the unexpected error (%v)", e)
+			} else if check != renderer {
+				t.Errorf("returned the (%v) renderer when expecting (%v)", check, renderer)
+			}
+		}
+	})
+}
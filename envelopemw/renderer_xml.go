@@ -0,0 +1,39 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// RendererXML defines a renderer that writes the response content
+// in XML format.
+type RendererXML struct{}
+
+var _ IRenderer = &RendererXML{}
+
+// NewRendererXML will instantiate a new XML response renderer.
+func NewRendererXML() *RendererXML {
+	return &RendererXML{}
+}
+
+// Mimes retrieves the list of the XML mime types.
+func (RendererXML) Mimes() []string {
+	return []string{gin.MIMEXML, gin.MIMEXML2, envelope.MIMEProblemXML}
+}
+
+// Render writes the given data as the response content in XML format.
+func (RendererXML) Render(
+	ctx *gin.Context,
+	mime string,
+	status int,
+	data interface{},
+) error {
+	// check the context argument reference
+	if ctx == nil {
+		return errNilPointer("ctx")
+	}
+	// write the response with the requested mime type
+	ctx.Header("Content-Type", mime+"; charset=utf-8")
+	ctx.XML(status, data)
+	return nil
+}
@@ -0,0 +1,59 @@
+package envelopemw
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func Test_RendererXML_Mimes(t *testing.T) {
+	t.Run("retrieve the xml mime types", func(t *testing.T) {
+		expected := []string{gin.MIMEXML, gin.MIMEXML2, envelope.MIMEProblemXML}
+
+		if check := NewRendererXML().Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime types when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_RendererXML_Render(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if e := NewRendererXML().Render(nil, gin.MIMEXML, http.StatusOK, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("render the data", func(t *testing.T) {
+		scenarios := []struct {
+			mime        string
+			contentType string
+		}{
+			{mime: gin.MIMEXML, contentType: "application/xml; charset=utf-8"},
+			{mime: gin.MIMEXML2, contentType: "text/xml; charset=utf-8"},
+			{mime: envelope.MIMEProblemXML, contentType: "application/problem+xml; charset=utf-8"},
+		}
+
+		for _, scenario := range scenarios {
+			writer := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(writer)
+			expected := `<envelope><status><success>true</success><error></error></status><data>data</data></envelope>`
+
+			switch e := NewRendererXML().Render(ctx, scenario.mime, http.StatusCreated, envelope.NewEnvelope(http.StatusCreated, "data")); {
+			case e != nil:
+				t.Errorf("returned the unexpected error (%v)", e)
+			case writer.Code != http.StatusCreated:
+				t.Errorf("written the (%v) status code", writer.Code)
+			case writer.Header().Get("Content-Type") != scenario.contentType:
+				t.Errorf("written the (%v) content type when expecting (%v)", writer.Header().Get("Content-Type"), scenario.contentType)
+			case writer.Body.String() != expected:
+				t.Errorf("written the (%v) body when expecting (%v)", writer.Body.String(), expected)
+			}
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package envelopemw
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// RendererYAML defines a renderer that writes the response content
+// in YAML format.
+type RendererYAML struct{}
+
+var _ IRenderer = &RendererYAML{}
+
+// NewRendererYAML will instantiate a new YAML response renderer.
+func NewRendererYAML() *RendererYAML {
+	return &RendererYAML{}
+}
+
+// Mimes retrieves the list of the YAML mime types.
+func (RendererYAML) Mimes() []string {
+	return []string{gin.MIMEYAML}
+}
+
+// Render writes the given data as the response content in YAML format.
+func (RendererYAML) Render(
+	ctx *gin.Context,
+	mime string,
+	status int,
+	data interface{},
+) error {
+	// check the context argument reference
+	if ctx == nil {
+		return errNilPointer("ctx")
+	}
+	// write the response with the requested mime type
+	ctx.Header("Content-Type", mime+"; charset=utf-8")
+	ctx.YAML(status, data)
+	return nil
+}
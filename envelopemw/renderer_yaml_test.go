@@ -0,0 +1,47 @@
+package envelopemw
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_RendererYAML_Mimes(t *testing.T) {
+	t.Run("retrieve the yaml mime types", func(t *testing.T) {
+		expected := []string{gin.MIMEYAML}
+
+		if check := NewRendererYAML().Mimes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) mime types when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_RendererYAML_Render(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if e := NewRendererYAML().Render(nil, gin.MIMEYAML, http.StatusOK, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("render the data", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		expected := "field: value\n"
+
+		switch e := NewRendererYAML().Render(ctx, gin.MIMEYAML, http.StatusCreated, map[string]string{"field": "value"}); {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case writer.Code != http.StatusCreated:
+			t.Errorf("written the (%v) status code", writer.Code)
+		case writer.Header().Get("Content-Type") != "application/x-yaml; charset=utf-8":
+			t.Errorf("written the (%v) content type", writer.Header().Get("Content-Type"))
+		case writer.Body.String() != expected:
+			t.Errorf("written the (%v) body when expecting (%v)", writer.Body.String(), expected)
+		}
+	})
+}
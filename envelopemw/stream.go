@@ -0,0 +1,288 @@
+package envelopemw
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+const (
+	// MIMENDJSON defines the newline delimited JSON mime type used
+	// to stream the envelope data records.
+	MIMENDJSON = "application/x-ndjson"
+)
+
+// streamWriter defines the interface of a response stream format
+// writer instance.
+type streamWriter interface {
+	begin() error
+	item(val interface{}) error
+	end(trailer *envelope.Envelope) error
+}
+
+// streamResponse will negotiate the stream format and write the stream
+// envelope records as they are retrieved from the envelope items channel.
+// The envelope status and reports are written after all the records, so
+// that a mid-stream error can still be reported in the envelope status.
+// Since the reports can be assigned until the items channel is closed,
+// they are omitted from a stream terminated by a mid-stream error.
+// The streams don't use the renderer registry, since the renderers write
+// whole documents, so only the built-in JSON, XML and NDJSON stream
+// formats are supported. If the stream is terminated before the items
+// channel is closed, the remaining items are drained in the background
+// so that a producer blocked on a send is released.
+func streamResponse(
+	ctx *gin.Context,
+	mapper IErrorMapper,
+	accepted []string,
+	stream *envelope.StreamEnvelope,
+	service,
+	endpoint int,
+) {
+	// drain the remaining items if the channel isn't consumed until closed
+	closed := false
+	defer func() {
+		if !closed && stream.Items != nil {
+			go drainStream(stream.Items)
+		}
+	}()
+	// negotiate the stream format with the streamable accepted formats
+	var offered []string
+	for _, mime := range accepted {
+		switch mime {
+		case gin.MIMEJSON, gin.MIMEXML, gin.MIMEXML2:
+			offered = append(offered, mime)
+		}
+	}
+	format := ctx.NegotiateFormat(append(offered, MIMENDJSON)...)
+	// instantiate the negotiated format stream writer
+	var writer streamWriter
+	switch format {
+	case gin.MIMEJSON:
+		writer = &streamWriterJSON{w: ctx.Writer}
+	case MIMENDJSON:
+		writer = &streamWriterNDJSON{w: ctx.Writer}
+	case gin.MIMEXML, gin.MIMEXML2:
+		writer = &streamWriterXML{w: ctx.Writer}
+	default:
+		ctx.AbortWithStatus(http.StatusNotAcceptable)
+		return
+	}
+	// write the response header and the stream starting section
+	ctx.Header("Content-Type", format+"; charset=utf-8")
+	ctx.Status(stream.StatusCode)
+	if e := writer.begin(); e != nil {
+		return
+	}
+	// write the stream records
+	trailer := envelope.NewEnvelope(stream.StatusCode, nil)
+	count := 0
+	for done := false; !done; {
+		select {
+		case <-requestDone(ctx):
+			// stop the stream if the request has been cancelled
+			return
+		case val, ok := <-stream.Items:
+			if !ok {
+				closed = true
+				done = true
+				break
+			}
+			// check for a mid-stream error
+			if e, isErr := val.(error); isErr {
+				trailer = streamError(mapper, e)
+				done = true
+				break
+			}
+			// write the record and periodically flush the response
+			if e := writer.item(val); e != nil {
+				trailer = streamError(mapper, e)
+				done = true
+				break
+			}
+			if count++; StreamFlushCount > 0 && count%StreamFlushCount == 0 {
+				ctx.Writer.Flush()
+			}
+		}
+	}
+	// compose and write the stream trailer
+	if closed {
+		trailer.SetListReport(stream.ListReport).SetCursorReport(stream.CursorReport)
+	}
+	trailer.SetService(service).SetEndpoint(endpoint)
+	if AbsoluteLinks || LinkHeader {
+		resolveReports(ctx.Request, trailer.ListReport, trailer.CursorReport, nil)
+	}
+	_ = writer.end(trailer)
+	ctx.Writer.Flush()
+}
+
+func drainStream(
+	items <-chan interface{},
+) {
+	for range items {
+	}
+}
+
+func requestDone(
+	ctx *gin.Context,
+) <-chan struct{} {
+	if ctx.Request == nil {
+		return nil
+	}
+	return ctx.Request.Context().Done()
+}
+
+func streamError(
+	mapper IErrorMapper,
+	e error,
+) *envelope.Envelope {
+	// map the error into the trailer envelope
+	response := mapper.Map(e)
+	if response == nil {
+		response = envelope.NewEnvelope(http.StatusInternalServerError, nil).
+			AddError(envelope.NewStatusError(0, "internal server error"))
+	}
+	return exposeError(response, e)
+}
+
+// streamWriterJSON defines a stream writer that writes the envelope as
+// a JSON object with the records in the data array.
+type streamWriterJSON struct {
+	w     io.Writer
+	count int
+}
+
+func (s *streamWriterJSON) begin() error {
+	_, e := io.WriteString(s.w, `{"data":[`)
+	return e
+}
+
+func (s *streamWriterJSON) item(
+	val interface{},
+) error {
+	b, e := json.Marshal(val)
+	if e != nil {
+		return e
+	}
+	if s.count != 0 {
+		if _, e := io.WriteString(s.w, ","); e != nil {
+			return e
+		}
+	}
+	s.count++
+	_, e = s.w.Write(b)
+	return e
+}
+
+func (s *streamWriterJSON) end(
+	trailer *envelope.Envelope,
+) error {
+	b, e := json.Marshal(trailer)
+	if e != nil {
+		return e
+	}
+	// merge the trailer object members into the streamed object
+	if _, e := io.WriteString(s.w, "],"); e != nil {
+		return e
+	}
+	_, e = s.w.Write(b[1:])
+	return e
+}
+
+// streamWriterNDJSON defines a stream writer that writes every record as
+// a JSON line followed by the envelope trailer as the last line.
+type streamWriterNDJSON struct {
+	w io.Writer
+}
+
+func (s *streamWriterNDJSON) begin() error {
+	return nil
+}
+
+func (s *streamWriterNDJSON) item(
+	val interface{},
+) error {
+	b, e := json.Marshal(val)
+	if e != nil {
+		return e
+	}
+	_, e = s.w.Write(append(b, '\n'))
+	return e
+}
+
+func (s *streamWriterNDJSON) end(
+	trailer *envelope.Envelope,
+) error {
+	return s.item(trailer)
+}
+
+// streamWriterXML defines a stream writer that writes the envelope as
+// a XML document with every record as a data element. The elements are
+// encoded into a scratch buffer, so that a record encoding error don't
+// leave a partially written element in the document.
+type streamWriterXML struct {
+	w io.Writer
+}
+
+func (s *streamWriterXML) begin() error {
+	_, e := io.WriteString(s.w, "<envelope>")
+	return e
+}
+
+func (s *streamWriterXML) item(
+	val interface{},
+) error {
+	return s.write(func(enc *xml.Encoder) error {
+		return enc.EncodeElement(val, xml.StartElement{Name: xml.Name{Local: "data"}})
+	})
+}
+
+func (s *streamWriterXML) end(
+	trailer *envelope.Envelope,
+) error {
+	// write the trailer sections
+	e := s.write(func(enc *xml.Encoder) error {
+		if e := enc.EncodeElement(trailer.Status, xml.StartElement{Name: xml.Name{Local: "status"}}); e != nil {
+			return e
+		}
+		if trailer.ListReport != nil {
+			if e := enc.EncodeElement(trailer.ListReport, xml.StartElement{Name: xml.Name{Local: "report"}}); e != nil {
+				return e
+			}
+		}
+		if trailer.CursorReport != nil {
+			if e := enc.EncodeElement(trailer.CursorReport, xml.StartElement{Name: xml.Name{Local: "cursor"}}); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+	if e != nil {
+		return e
+	}
+	_, e = io.WriteString(s.w, "</envelope>")
+	return e
+}
+
+func (s *streamWriterXML) write(
+	encode func(enc *xml.Encoder) error,
+) error {
+	// encode the elements into the scratch buffer
+	b := bytes.Buffer{}
+	enc := xml.NewEncoder(&b)
+	if e := encode(enc); e != nil {
+		return e
+	}
+	if e := enc.Flush(); e != nil {
+		return e
+	}
+	// write the encoded elements only after a successful encoding
+	_, e := s.w.Write(b.Bytes())
+	return e
+}
@@ -0,0 +1,231 @@
+package envelopemw
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+type streamTestRecord struct {
+	ID int `json:"id" xml:"id"`
+}
+
+type streamTestInvalidRecord struct {
+	ID      int      `json:"id" xml:"id"`
+	Channel chan int `json:"channel" xml:"channel"`
+}
+
+func Test_streamResponse(t *testing.T) {
+	prev := AbsoluteLinks
+	AbsoluteLinks = true
+	defer func() { AbsoluteLinks = prev }()
+
+	newContext := func(accept string) (*gin.Context, *httptest.ResponseRecorder) {
+		gin.SetMode(gin.ReleaseMode)
+		writer := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(writer)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://domain.com/resource", nil)
+		if accept != "" {
+			ctx.Request.Header.Set("Accept", accept)
+		}
+		return ctx, writer
+	}
+	newStream := func(items ...interface{}) *envelope.StreamEnvelope {
+		ch := make(chan interface{}, len(items))
+		for _, item := range items {
+			ch <- item
+		}
+		close(ch)
+		return envelope.NewStreamEnvelope(http.StatusOK, ch)
+	}
+	accepted := []string{gin.MIMEJSON, gin.MIMEXML, gin.MIMEYAML}
+
+	scenarios := []struct {
+		name     string
+		accept   string
+		stream   *envelope.StreamEnvelope
+		mime     string
+		expected string
+	}{
+		{
+			name:     "empty json stream",
+			accept:   gin.MIMEJSON,
+			stream:   newStream(),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[],"status":{"success":true,"error":[]}}`,
+		},
+		{
+			name:     "json stream",
+			accept:   "",
+			stream:   newStream(streamTestRecord{ID: 1}, streamTestRecord{ID: 2}),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[{"id":1},{"id":2}],"status":{"success":true,"error":[]}}`,
+		},
+		{
+			name:     "json stream with report",
+			accept:   gin.MIMEJSON,
+			stream:   newStream(streamTestRecord{ID: 1}).SetListReport(envelope.NewListReport("", 0, 1, 1)),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[{"id":1}],"status":{"success":true,"error":[]},"report":{"search":"","start":0,"count":1,"total":1,"first":"http://domain.com/resource?search=\u0026start=0\u0026count=1","prev":"","next":"","last":"http://domain.com/resource?search=\u0026start=0\u0026count=1"}}`,
+		},
+		{
+			name:     "json stream with mid-stream error",
+			accept:   gin.MIMEJSON,
+			stream:   newStream(streamTestRecord{ID: 1}, fmt.Errorf("error message"), streamTestRecord{ID: 2}),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[{"id":1}],"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`,
+		},
+		{
+			name:     "json stream with mapped mid-stream error",
+			accept:   gin.MIMEJSON,
+			stream:   newStream(NewEnvelopeError(http.StatusConflict, 3, "conflict")),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[],"status":{"success":false,"error":[{"code":"s:1.e:2.c:3","message":"conflict"}]}}`,
+		},
+		{
+			name:     "json stream with record encoding error",
+			accept:   gin.MIMEJSON,
+			stream:   newStream(streamTestRecord{ID: 1}, make(chan int)),
+			mime:     gin.MIMEJSON,
+			expected: `{"data":[{"id":1}],"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`,
+		},
+		{
+			name:     "ndjson stream",
+			accept:   MIMENDJSON,
+			stream:   newStream(streamTestRecord{ID: 1}, streamTestRecord{ID: 2}),
+			mime:     MIMENDJSON,
+			expected: "{\"id\":1}\n{\"id\":2}\n{\"status\":{\"success\":true,\"error\":[]}}\n",
+		},
+		{
+			name:     "xml stream",
+			accept:   gin.MIMEXML,
+			stream:   newStream(streamTestRecord{ID: 1}, streamTestRecord{ID: 2}),
+			mime:     gin.MIMEXML,
+			expected: `<envelope><data><id>1</id></data><data><id>2</id></data><status><success>true</success><error></error></status></envelope>`,
+		},
+		{
+			name:     "xml stream with reports",
+			accept:   gin.MIMEXML,
+			stream:   newStream(streamTestRecord{ID: 1}).SetListReport(envelope.NewListReport("", 0, 1, 1)).SetCursorReport(envelope.NewCursorReport(nil, 1, "", "")),
+			mime:     gin.MIMEXML,
+			expected: `<envelope><data><id>1</id></data><status><success>true</success><error></error></status><report><search></search><start>0</start><count>1</count><total>1</total><first>http://domain.com/resource?search=&amp;start=0&amp;count=1</first><prev></prev><next></next><last>http://domain.com/resource?search=&amp;start=0&amp;count=1</last></report><cursor><count>1</count><prev></prev><next></next></cursor></envelope>`,
+		},
+		{
+			name:     "xml stream with mid-stream error",
+			accept:   gin.MIMEXML,
+			stream:   newStream(streamTestRecord{ID: 1}, fmt.Errorf("error message")).SetListReport(envelope.NewListReport("", 0, 1, 1)),
+			mime:     gin.MIMEXML,
+			expected: `<envelope><data><id>1</id></data><status><success>false</success><error><error code="s:1.e:2.c:0" message="internal server error"></error></error></status></envelope>`,
+		},
+		{
+			name:     "xml stream with record encoding error",
+			accept:   gin.MIMEXML,
+			stream:   newStream(streamTestRecord{ID: 1}, streamTestInvalidRecord{ID: 2, Channel: make(chan int)}),
+			mime:     gin.MIMEXML,
+			expected: `<envelope><data><id>1</id></data><status><success>false</success><error><error code="s:1.e:2.c:0" message="internal server error"></error></error></status></envelope>`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ctx, writer := newContext(s.accept)
+			streamResponse(ctx, NewErrorMapper(), accepted, s.stream, 1, 2)
+
+			if check := writer.Code; check != http.StatusOK {
+				t.Errorf("responded with the (%v) status code", check)
+			} else if check := writer.Header().Get("Content-Type"); check != s.mime+"; charset=utf-8" {
+				t.Errorf("responded with the (%v) content type", check)
+			} else if check := writer.Body.String(); check != s.expected {
+				t.Errorf("streamed (%v) when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+
+	t.Run("not acceptable stream format", func(t *testing.T) {
+		ctx, writer := newContext(gin.MIMEYAML)
+		streamResponse(ctx, NewErrorMapper(), accepted, newStream(streamTestRecord{ID: 1}), 1, 2)
+
+		if check := writer.Code; check != http.StatusNotAcceptable {
+			t.Errorf("responded with the (%v) status code", check)
+		} else if check := writer.Body.String(); check != "" {
+			t.Errorf("streamed the unexpected (%v) content", check)
+		}
+	})
+
+	t.Run("periodically flush the response", func(t *testing.T) {
+		prev := StreamFlushCount
+		StreamFlushCount = 1
+		defer func() { StreamFlushCount = prev }()
+
+		ctx, writer := newContext(gin.MIMEJSON)
+		streamResponse(ctx, NewErrorMapper(), accepted, newStream(streamTestRecord{ID: 1}), 1, 2)
+
+		if !writer.Flushed {
+			t.Error("didn't flushed the response")
+		}
+	})
+
+	t.Run("stop the stream on request cancellation", func(t *testing.T) {
+		ctx, writer := newContext(gin.MIMEJSON)
+		cancelCtx, cancel := context.WithCancel(ctx.Request.Context())
+		ctx.Request = ctx.Request.WithContext(cancelCtx)
+		cancel()
+		items := make(chan interface{})
+		streamResponse(ctx, NewErrorMapper(), accepted, envelope.NewStreamEnvelope(http.StatusOK, items), 1, 2)
+
+		if check := writer.Body.String(); check != `{"data":[` {
+			t.Errorf("streamed (%v) when expecting only the stream start", check)
+		}
+	})
+	t.Run("release the producer on mid-stream request cancellation", func(t *testing.T) {
+		ctx, _ := newContext(gin.MIMEJSON)
+		cancelCtx, cancel := context.WithCancel(ctx.Request.Context())
+		defer cancel()
+		ctx.Request = ctx.Request.WithContext(cancelCtx)
+		items := make(chan interface{})
+		finished := make(chan struct{})
+		go func() {
+			defer close(finished)
+			defer close(items)
+			items <- streamTestRecord{ID: 1}
+			cancel()
+			for i := 2; i <= 10; i++ {
+				items <- streamTestRecord{ID: i}
+			}
+		}()
+		streamResponse(ctx, NewErrorMapper(), accepted, envelope.NewStreamEnvelope(http.StatusOK, items), 1, 2)
+
+		select {
+		case <-finished:
+		case <-time.After(time.Second):
+			t.Error("didn't released the blocked producer")
+		}
+	})
+
+	t.Run("don't read the reports of a stream terminated by an error", func(t *testing.T) {
+		ctx, writer := newContext(gin.MIMEJSON)
+		items := make(chan interface{})
+		stream := envelope.NewStreamEnvelope(http.StatusOK, items)
+		finished := make(chan struct{})
+		go func() {
+			defer close(finished)
+			defer close(items)
+			items <- streamTestRecord{ID: 1}
+			items <- fmt.Errorf("error message")
+			stream.SetListReport(envelope.NewListReport("", 0, 1, 1))
+		}()
+		streamResponse(ctx, NewErrorMapper(), accepted, stream, 1, 2)
+		<-finished
+
+		expected := `{"data":[{"id":1}],"status":{"success":false,"error":[{"code":"s:1.e:2.c:0","message":"internal server error"}]}}`
+		if check := writer.Body.String(); check != expected {
+			t.Errorf("streamed (%v) when expecting (%v)", check, expected)
+		}
+	})
+}
@@ -6,6 +6,16 @@ import (
 	"github.com/happyhippyhippo/slate"
 )
 
+var (
+	// ErrDuplicateRoute defines an error that signal that a route
+	// name has already been used by another route.
+	ErrDuplicateRoute = fmt.Errorf("duplicate route name")
+
+	// ErrRouteNotFound defines an error that signal that a named
+	// route could not be found.
+	ErrRouteNotFound = fmt.Errorf("route not found")
+)
+
 func errNilPointer(
 	arg string,
 	ctx ...map[string]interface{},
@@ -20,3 +30,17 @@ func errConversion(
 ) error {
 	return slate.NewErrorFrom(slate.ErrConversion, fmt.Sprintf("%v to %s", val, t), ctx...)
 }
+
+func errDuplicateRoute(
+	name string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrDuplicateRoute, name, ctx...)
+}
+
+func errRouteNotFound(
+	name string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrRouteNotFound, name, ctx...)
+}
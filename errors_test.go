@@ -68,3 +68,63 @@ func Test_errConversion(t *testing.T) {
 		}
 	})
 }
+
+func Test_errDuplicateRoute(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : duplicate route name"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errDuplicateRoute(arg); !errors.Is(e, ErrDuplicateRoute) {
+			t.Errorf("error not a instance of ErrDuplicateRoute")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errDuplicateRoute(arg, context); !errors.Is(e, ErrDuplicateRoute) {
+			t.Errorf("error not a instance of ErrDuplicateRoute")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errRouteNotFound(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : route not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errRouteNotFound(arg); !errors.Is(e, ErrRouteNotFound) {
+			t.Errorf("error not a instance of ErrRouteNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errRouteNotFound(arg, context); !errors.Is(e, ErrRouteNotFound) {
+			t.Errorf("error not a instance of ErrRouteNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
@@ -4,6 +4,13 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// EnvelopedContextKey defines the gin context key used by the envelope
+	// middleware to signal the inner middlewares that the response stored
+	// in the "response" context key will be rendered by it.
+	EnvelopedContextKey = ID + ".enveloped"
+)
+
 // Middleware defines a type of data that represents
 // a rest method middleware function.
 type Middleware func(gin.HandlerFunc) gin.HandlerFunc
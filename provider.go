@@ -3,6 +3,7 @@ package rest
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/log"
 	"github.com/happyhippyhippo/slate/watchdog"
 )
 
@@ -19,6 +20,10 @@ const (
 	// container registration id of the rest watchdog process.
 	ProcessID = ID + ".process"
 
+	// RouteRegistryID defines the id to be used as the
+	// container registration id of the named route registry instance.
+	RouteRegistryID = ID + ".routes"
+
 	// EndpointRegisterTag defines the tag to be used as the
 	// identification of a controller's registration instance.
 	EndpointRegisterTag = ID + ".register"
@@ -42,6 +47,8 @@ func (p Provider) Register(
 	_ = container[0].Service(EngineID, func() Engine {
 		return gin.New()
 	})
+	// add the named route registry
+	_ = container[0].Service(RouteRegistryID, NewRouteRegistry)
 	// add REST watchdog process instance
 	_ = container[0].Service(ProcessID, NewProcess, watchdog.ProcessTag)
 	return nil
@@ -71,6 +78,17 @@ func (p Provider) Boot(
 			return e
 		}
 	}
+	// warn about the named routes not registered in the engine, as
+	// they can still be registered after the provider boot
+	routes, e := p.getRouteRegistry(container[0])
+	if e != nil {
+		return e
+	}
+	logger, e := p.getLogger(container[0])
+	if e != nil {
+		return e
+	}
+	p.checkRoutes(engine, routes, logger)
 	return nil
 }
 
@@ -90,6 +108,58 @@ func (Provider) getEngine(
 	return instance, nil
 }
 
+func (Provider) getRouteRegistry(
+	container slate.IContainer,
+) (IRouteRegistry, error) {
+	// retrieve the route registry entry
+	entry, e := container.Get(RouteRegistryID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(IRouteRegistry)
+	if !ok {
+		return nil, errConversion(entry, "rest.IRouteRegistry")
+	}
+	return instance, nil
+}
+
+func (Provider) getLogger(
+	container slate.IContainer,
+) (log.ILog, error) {
+	// retrieve the logger entry
+	entry, e := container.Get(log.ID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(log.ILog)
+	if !ok {
+		return nil, errConversion(entry, "log.ILog")
+	}
+	return instance, nil
+}
+
+func (Provider) checkRoutes(
+	engine Engine,
+	routes IRouteRegistry,
+	logger log.ILog,
+) {
+	// index the engine registered routes
+	registered := map[string]bool{}
+	for _, info := range engine.Routes() {
+		registered[info.Method+" "+info.Path] = true
+	}
+	// signal all the named routes not registered in the engine
+	for _, route := range routes.Routes() {
+		if !registered[route.Method+" "+route.Path] {
+			_ = logger.Signal(LogChannel, log.WARNING, LogRouteNotFoundMessage, log.Context{
+				"error": errRouteNotFound(route.Name, map[string]interface{}{"method": route.Method, "path": route.Path}),
+			})
+		}
+	}
+}
+
 func (Provider) getRegisters(
 	container slate.IContainer,
 ) ([]IEndpointRegister, error) {
@@ -3,8 +3,10 @@ package rest
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/happyhippyhippo/slate"
 	"github.com/happyhippyhippo/slate/config"
@@ -41,6 +43,8 @@ func Test_Provider_Register(t *testing.T) {
 			t.Errorf("didn't registered the REST engine instance : %v", sut)
 		case !container.Has(ProcessID):
 			t.Errorf("didn't registered the watchdog process instance : %v", sut)
+		case !container.Has(RouteRegistryID):
+			t.Errorf("didn't registered the route registry instance : %v", sut)
 		}
 	})
 
@@ -221,6 +225,137 @@ func Test_Provider_Boot(t *testing.T) {
 		}
 	})
 
+	t.Run("error retrieving route registry", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(RouteRegistryID, func() (IRouteRegistry, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid route registry reference", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(RouteRegistryID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving logger", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(log.ID, func() (log.ILog, error) { return nil, expected })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid logger reference", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(log.ID, func() string { return "string" })
+
+		if e := sut.Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("warn about named route not registered in the engine", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := slate.NewContainer()
+		sut := &Provider{}
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.WARNING, LogRouteNotFoundMessage, gomock.Any()).Return(nil).Times(1)
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(log.ID, func() log.ILog { return logger })
+		routes, _ := sut.getRouteRegistry(container)
+		_ = routes.Name("users.get", http.MethodGet, "/users/:id")
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the unexpected error : %v", e)
+		}
+	})
+
+	t.Run("successful boot with named router group routes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := slate.NewContainer()
+		sut := &Provider{}
+		logger := NewMockLog(ctrl)
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		_ = container.Service(log.ID, func() log.ILog { return logger })
+		engine, _ := sut.getEngine(container)
+		routes, _ := sut.getRouteRegistry(container)
+		_ = routes.Handle(engine.(*gin.Engine).Group("/v1"), "users.get", http.MethodGet, "/users/:id", func(*gin.Context) {})
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the unexpected error : %v", e)
+		}
+	})
+
+	t.Run("successful boot with named routes", func(t *testing.T) {
+		container := slate.NewContainer()
+		sut := &Provider{}
+
+		_ = (fs.Provider{}).Register(container)
+		_ = (config.Provider{}).Register(container)
+		_ = (log.Provider{}).Register(container)
+		_ = sut.Register(container)
+		engine, _ := sut.getEngine(container)
+		routes, _ := sut.getRouteRegistry(container)
+		_ = routes.Handle(engine, "users.get", http.MethodGet, "/users/:id", func(*gin.Context) {})
+
+		if e := sut.Boot(container); e != nil {
+			t.Errorf("returned the unexpected error : %v", e)
+		}
+	})
+
 	t.Run("successful boot", func(t *testing.T) {
 		container := slate.NewContainer()
 		sut := &Provider{}
@@ -0,0 +1,214 @@
+package rest
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// Route defines the information of a named engine route.
+type Route struct {
+	Name   string
+	Method string
+	Path   string
+}
+
+// IRouteRegistry defines the interface of a named route registry
+// instance used to build the hypermedia links of the registered routes.
+type IRouteRegistry interface {
+	Handle(router gin.IRoutes, name, method, path string, handlers ...gin.HandlerFunc) error
+	Name(name, method, path string) error
+	Route(name string) (*Route, error)
+	Routes() []*Route
+	URL(name string, params ...map[string]string) (string, error)
+	Link(name string, params ...map[string]string) (*envelope.Link, error)
+}
+
+// RouteRegistry defines a named route registry instance.
+type RouteRegistry struct {
+	mutex  sync.RWMutex
+	names  []string
+	routes map[string]*Route
+}
+
+var _ IRouteRegistry = &RouteRegistry{}
+
+// NewRouteRegistry will instantiate a new empty named route registry.
+func NewRouteRegistry() IRouteRegistry {
+	return &RouteRegistry{
+		names:  []string{},
+		routes: map[string]*Route{},
+	}
+}
+
+// Handle will register the route handlers in the given engine or
+// router group and store the route with the given name. The route is
+// stored with its full path, prefixed by the router group base path.
+func (r *RouteRegistry) Handle(
+	router gin.IRoutes,
+	name,
+	method,
+	path string,
+	handlers ...gin.HandlerFunc,
+) error {
+	// check the router argument reference
+	if router == nil {
+		return errNilPointer("router")
+	}
+	// resolve the route full path against the router group base path
+	full := path
+	if group, ok := router.(interface{ BasePath() string }); ok {
+		full = joinPaths(group.BasePath(), path)
+	}
+	// name the route before the registration, so a duplicate
+	// route name don't register the route handlers
+	if e := r.Name(name, method, full); e != nil {
+		return e
+	}
+	router.Handle(strings.ToUpper(method), path, handlers...)
+	return nil
+}
+
+// Name will store the route with the given method and path under the
+// given name.
+func (r *RouteRegistry) Name(
+	name,
+	method,
+	path string,
+) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// check for a duplicate route name
+	if _, ok := r.routes[name]; ok {
+		return errDuplicateRoute(name)
+	}
+	r.names = append(r.names, name)
+	r.routes[name] = &Route{
+		Name:   name,
+		Method: strings.ToUpper(method),
+		Path:   path,
+	}
+	return nil
+}
+
+// Route will retrieve the route stored with the given name.
+func (r *RouteRegistry) Route(
+	name string,
+) (*Route, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	route, ok := r.routes[name]
+	if !ok {
+		return nil, errRouteNotFound(name)
+	}
+	return route, nil
+}
+
+// Routes will retrieve all the stored routes in the order of their naming.
+func (r *RouteRegistry) Routes() []*Route {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	routes := make([]*Route, 0, len(r.names))
+	for _, name := range r.names {
+		routes = append(routes, r.routes[name])
+	}
+	return routes
+}
+
+// URL will build the location of the route stored with the given name.
+// The route path parameters are replaced by the given values, and the
+// ones without a value are replaced by URI template expressions.
+func (r *RouteRegistry) URL(
+	name string,
+	params ...map[string]string,
+) (string, error) {
+	location, _, e := r.build(name, params...)
+	return location, e
+}
+
+// Link will build a hypermedia link to the route stored with the given
+// name. The link is flagged as templated if any route path parameter
+// value was not given.
+func (r *RouteRegistry) Link(
+	name string,
+	params ...map[string]string,
+) (*envelope.Link, error) {
+	location, templated, e := r.build(name, params...)
+	if e != nil {
+		return nil, e
+	}
+	route, _ := r.Route(name)
+	return envelope.NewLink(location).
+		SetMethod(route.Method).
+		SetTemplated(templated), nil
+}
+
+func (r *RouteRegistry) build(
+	name string,
+	params ...map[string]string,
+) (string, bool, error) {
+	// retrieve the named route
+	route, e := r.Route(name)
+	if e != nil {
+		return "", false, e
+	}
+	// merge the given parameter values
+	values := map[string]string{}
+	for _, p := range params {
+		for k, v := range p {
+			values[k] = v
+		}
+	}
+	// replace the path parameters segments
+	templated := false
+	segments := strings.Split(route.Path, "/")
+	for i, segment := range segments {
+		if segment == "" || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		param := segment[1:]
+		value, ok := values[param]
+		switch {
+		case !ok:
+			segments[i] = "{" + param + "}"
+			templated = true
+		case segment[0] == '*':
+			segments[i] = escapeSegments(strings.TrimPrefix(value, "/"))
+		default:
+			segments[i] = url.PathEscape(value)
+		}
+	}
+	return strings.Join(segments, "/"), templated, nil
+}
+
+func joinPaths(
+	base,
+	relative string,
+) string {
+	// join the paths the same way the gin router groups do
+	if relative == "" {
+		return base
+	}
+	joined := path.Join(base, relative)
+	if strings.HasSuffix(relative, "/") && !strings.HasSuffix(joined, "/") {
+		return joined + "/"
+	}
+	return joined
+}
+
+func escapeSegments(
+	value string,
+) string {
+	segments := strings.Split(value, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
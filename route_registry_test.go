@@ -0,0 +1,227 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewRouteRegistry(t *testing.T) {
+	t.Run("new route registry", func(t *testing.T) {
+		if sut := NewRouteRegistry(); sut == nil {
+			t.Error("didn't returned a valid reference")
+		} else if check := sut.Routes(); len(check) != 0 {
+			t.Errorf("stored the (%v) routes", check)
+		}
+	})
+}
+
+func Test_RouteRegistry_Handle(t *testing.T) {
+	t.Run("nil engine", func(t *testing.T) {
+		if e := NewRouteRegistry().Handle(nil, "name", http.MethodGet, "/"); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("duplicate route name", func(t *testing.T) {
+		engine := gin.New()
+		sut := NewRouteRegistry()
+		_ = sut.Handle(engine, "name", http.MethodGet, "/first", func(*gin.Context) {})
+
+		if e := sut.Handle(engine, "name", http.MethodGet, "/second", func(*gin.Context) {}); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrDuplicateRoute) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrDuplicateRoute)
+		} else if check := engine.Routes(); len(check) != 1 {
+			t.Errorf("registered the (%v) engine routes", check)
+		}
+	})
+
+	t.Run("register and name the route", func(t *testing.T) {
+		engine := gin.New()
+		sut := NewRouteRegistry()
+
+		if e := sut.Handle(engine, "users.get", "get", "/users/:id", func(*gin.Context) {}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if check := engine.Routes(); len(check) != 1 || check[0].Method != http.MethodGet || check[0].Path != "/users/:id" {
+			t.Errorf("registered the (%v) engine routes", check)
+		} else if route, _ := sut.Route("users.get"); route == nil || route.Method != http.MethodGet {
+			t.Errorf("stored the (%v) route", route)
+		}
+	})
+
+	t.Run("register and name a router group route", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			base     string
+			path     string
+			expected string
+		}{
+			{ // group relative route
+				test:     "group relative route",
+				base:     "/v1",
+				path:     "/users/:id",
+				expected: "/v1/users/:id",
+			},
+			{ // group root route
+				test:     "group root route",
+				base:     "/v1",
+				path:     "",
+				expected: "/v1",
+			},
+			{ // route with trailing slash
+				test:     "route with trailing slash",
+				base:     "/v1/",
+				path:     "users/",
+				expected: "/v1/users/",
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				engine := gin.New()
+				sut := NewRouteRegistry()
+
+				if e := sut.Handle(engine.Group(s.base), "users", http.MethodGet, s.path, func(*gin.Context) {}); e != nil {
+					t.Errorf("returned the unexpected (%v) error", e)
+				} else if check := engine.Routes(); len(check) != 1 || check[0].Path != s.expected {
+					t.Errorf("registered the (%v) engine routes", check)
+				} else if route, _ := sut.Route("users"); route == nil || route.Path != s.expected {
+					t.Errorf("stored the (%v) route", route)
+				}
+			})
+		}
+	})
+}
+
+func Test_RouteRegistry_Name(t *testing.T) {
+	t.Run("duplicate route name", func(t *testing.T) {
+		sut := NewRouteRegistry()
+		_ = sut.Name("name", http.MethodGet, "/")
+
+		if e := sut.Name("name", http.MethodPost, "/"); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrDuplicateRoute) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrDuplicateRoute)
+		}
+	})
+
+	t.Run("store routes in naming order", func(t *testing.T) {
+		sut := NewRouteRegistry()
+		_ = sut.Name("second", http.MethodPost, "/users")
+		_ = sut.Name("first", "get", "/users/:id")
+		expected := []*Route{
+			{Name: "second", Method: http.MethodPost, Path: "/users"},
+			{Name: "first", Method: http.MethodGet, Path: "/users/:id"},
+		}
+
+		if check := sut.Routes(); !reflect.DeepEqual(check, expected) {
+			t.Errorf("stored the (%v) routes when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_RouteRegistry_Route(t *testing.T) {
+	t.Run("route not found", func(t *testing.T) {
+		if route, e := NewRouteRegistry().Route("name"); route != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrRouteNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrRouteNotFound)
+		}
+	})
+}
+
+func Test_RouteRegistry_URL(t *testing.T) {
+	sut := NewRouteRegistry()
+	_ = sut.Name("users", http.MethodGet, "/users")
+	_ = sut.Name("users.get", http.MethodGet, "/users/:id")
+	_ = sut.Name("files", http.MethodGet, "/users/:id/files/*path")
+
+	scenarios := []struct {
+		name     string
+		params   []map[string]string
+		expected string
+	}{
+		{ // route without parameters
+			name:     "users",
+			expected: "/users",
+		},
+		{ // route with a parameter value
+			name:     "users.get",
+			params:   []map[string]string{{"id": "1"}},
+			expected: "/users/1",
+		},
+		{ // route with an escaped parameter value
+			name:     "users.get",
+			params:   []map[string]string{{"id": "a b/c"}},
+			expected: "/users/a%20b%2Fc",
+		},
+		{ // route without the parameter value
+			name:     "users.get",
+			expected: "/users/{id}",
+		},
+		{ // route with a catch-all parameter value
+			name:     "files",
+			params:   []map[string]string{{"id": "1"}, {"path": "/dir/file name"}},
+			expected: "/users/1/files/dir/file%20name",
+		},
+		{ // route without the catch-all parameter value
+			name:     "files",
+			params:   []map[string]string{{"id": "1"}},
+			expected: "/users/1/files/{path}",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		test := fmt.Sprintf("%s %v", scenario.name, scenario.params)
+		t.Run(test, func(t *testing.T) {
+			if check, e := sut.URL(scenario.name, scenario.params...); e != nil {
+				t.Errorf("returned the unexpected (%v) error", e)
+			} else if check != scenario.expected {
+				t.Errorf("built the (%v) url when expecting (%v)", check, scenario.expected)
+			}
+		})
+	}
+
+	t.Run("route not found", func(t *testing.T) {
+		if _, e := sut.URL("unknown"); !errors.Is(e, ErrRouteNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrRouteNotFound)
+		}
+	})
+}
+
+func Test_RouteRegistry_Link(t *testing.T) {
+	sut := NewRouteRegistry()
+	_ = sut.Name("users.delete", http.MethodDelete, "/users/:id")
+
+	t.Run("route not found", func(t *testing.T) {
+		if link, e := sut.Link("unknown"); link != nil {
+			t.Error("returned a valid reference")
+		} else if !errors.Is(e, ErrRouteNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrRouteNotFound)
+		}
+	})
+
+	t.Run("build the resource link", func(t *testing.T) {
+		if link, e := sut.Link("users.delete", map[string]string{"id": "1"}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if link.Href != "/users/1" || link.Method != http.MethodDelete || link.Templated {
+			t.Errorf("built the (%v) link", link)
+		}
+	})
+
+	t.Run("build the templated link", func(t *testing.T) {
+		if link, e := sut.Link("users.delete"); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if link.Href != "/users/{id}" || link.Method != http.MethodDelete || !link.Templated {
+			t.Errorf("built the (%v) link", link)
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// Binder is a function type used to define a calling interface of
+// function responsible to bind the request path, query, header and body
+// data into a structure and validate it, returning an initialized
+// response envelope with the founded binding or validation errors
+// translated to the request Accept-Language header locales
+type Binder func(ctx *gin.Context, target interface{}) (*envelope.Envelope, error)
+
+// NewBinder instantiates a new request binding function. The request
+// body is decoded by its content type, where JSON, XML and form bodies
+// are supported, and any other body content type results in an
+// unsupported media type error envelope. The form body values are bound
+// merged over the query parameters values, so the form tag default
+// values are only applied to the fields absent from both sources.
+func NewBinder(
+	validator Validator,
+	parser IParser,
+) (Binder, error) {
+	// check validator argument reference
+	if validator == nil {
+		return nil, errNilPointer("validator")
+	}
+	// check parser argument reference
+	if parser == nil {
+		return nil, errNilPointer("parser")
+	}
+	// return the binding method instance
+	return func(ctx *gin.Context, target interface{}) (*envelope.Envelope, error) {
+		// check the context argument reference
+		if ctx == nil || ctx.Request == nil {
+			return nil, errNilPointer("ctx")
+		}
+		// check the target argument to be a structure pointer
+		if target == nil {
+			return nil, errNilPointer("target")
+		}
+		if v := reflect.ValueOf(target); v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return nil, errConversion(target, "struct pointer")
+		}
+		// retrieve the request locales
+		locale := AcceptLanguages(ctx)
+		// bind the request path parameters
+		params := map[string][]string{}
+		for _, param := range ctx.Params {
+			params[param.Key] = []string{param.Value}
+		}
+		if e := binding.MapFormWithTag(target, params, "uri"); e != nil {
+			return parser.ParseBinding(BindURI, e, locale...)
+		}
+		// bind the request query parameters
+		query := url.Values{}
+		if ctx.Request.URL != nil {
+			query = ctx.Request.URL.Query()
+			if e := binding.MapFormWithTag(target, query, "form"); e != nil {
+				return parser.ParseBinding(BindQuery, e, locale...)
+			}
+		}
+		// bind the request headers
+		headers := map[string][]string{}
+		headerValues(reflect.TypeOf(target).Elem(), ctx.Request.Header, headers)
+		if e := binding.MapFormWithTag(target, headers, "header"); e != nil {
+			return parser.ParseBinding(BindHeader, e, locale...)
+		}
+		// bind the request body
+		if e := bindBody(ctx, target, query); e != nil {
+			if errors.Is(e, ErrUnsupportedMediaType) {
+				return parser.ParseBinding(BindMediaType, e, locale...)
+			}
+			return parser.ParseBinding(BindBody, e, locale...)
+		}
+		// validate the bound structure
+		return validator(target, locale...)
+	}, nil
+}
+
+// Bind will bind and validate the request data into a new instance
+// of the given structure type. The returned envelope holds the binding
+// or validation errors if the request data is invalid.
+func Bind[T any](
+	ctx *gin.Context,
+	binder Binder,
+) (*T, *envelope.Envelope, error) {
+	// check binder argument reference
+	if binder == nil {
+		return nil, nil, errNilPointer("binder")
+	}
+	// bind the request into a new structure instance
+	target := new(T)
+	env, e := binder(ctx, target)
+	switch {
+	case e != nil:
+		return nil, nil, e
+	case env != nil:
+		return nil, env, nil
+	}
+	return target, nil, nil
+}
+
+func bindBody(
+	ctx *gin.Context,
+	target interface{},
+	query url.Values,
+) error {
+	// no-op if the request has no body
+	req := ctx.Request
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0 {
+		return nil
+	}
+	// decode the body by the request content type
+	switch ctx.ContentType() {
+	case binding.MIMEJSON:
+		if e := json.NewDecoder(req.Body).Decode(target); e != nil && !errors.Is(e, io.EOF) {
+			return e
+		}
+	case binding.MIMEXML, binding.MIMEXML2:
+		if e := xml.NewDecoder(req.Body).Decode(target); e != nil && !errors.Is(e, io.EOF) {
+			return e
+		}
+	case binding.MIMEPOSTForm:
+		if e := req.ParseForm(); e != nil {
+			return e
+		}
+		return binding.MapFormWithTag(target, mergeValues(query, req.PostForm), "form")
+	case binding.MIMEMultipartPOSTForm:
+		if e := req.ParseMultipartForm(32 << 20); e != nil {
+			return e
+		}
+		return binding.MapFormWithTag(target, mergeValues(query, req.MultipartForm.Value), "form")
+	default:
+		return errUnsupportedMediaType(ctx.ContentType())
+	}
+	return nil
+}
+
+// mergeValues will compose the form binding values with the body values
+// overriding the query values with the same name, so the query values
+// bound previously aren't reset to the form tag default values.
+func mergeValues(
+	query,
+	body map[string][]string,
+) map[string][]string {
+	values := map[string][]string{}
+	for name, v := range query {
+		values[name] = v
+	}
+	for name, v := range body {
+		values[name] = v
+	}
+	return values
+}
+
+// headerValues will collect the values of the request headers referenced
+// by the structure fields header tags, so they can be mapped regardless
+// of the header name case used in the tag.
+func headerValues(
+	t reflect.Type,
+	header http.Header,
+	values map[string][]string,
+) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if name, ok := field.Tag.Lookup("header"); ok && name != "" && name != "-" {
+			if v := header.Values(name); len(v) != 0 {
+				values[name] = v
+			}
+			continue
+		}
+		headerValues(field.Type, header, values)
+	}
+}
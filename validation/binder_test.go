@@ -0,0 +1,375 @@
+package validation
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	translations "github.com/go-playground/validator/v10/translations/en"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+type binderTestRequest struct {
+	ID      int    `uri:"id" validate:"gt=0"`
+	Search  string `form:"search"`
+	Request string `header:"x-request-id"`
+	Name    string `json:"name" xml:"name" form:"name" validate:"required"`
+	Age     int    `json:"age" xml:"age" form:"age"`
+}
+
+func newTestBinder() Binder {
+	lang := en.New()
+	translator, _ := ut.New(lang, lang).GetTranslator("en")
+	validate := validator.New()
+	_ = translations.RegisterDefaultTranslations(validate, translator)
+	parser, _ := NewParser(staticLocalizer(translator))
+	v, _ := NewValidator(validate, parser)
+	binder, _ := NewBinder(v, parser)
+	return binder
+}
+
+func newTestBinderContext(
+	method,
+	target,
+	contentType,
+	body string,
+	params gin.Params,
+) *gin.Context {
+	gin.SetMode(gin.ReleaseMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	if body == "" {
+		ctx.Request = httptest.NewRequest(method, target, nil)
+	}
+	if contentType != "" {
+		ctx.Request.Header.Set("Content-Type", contentType)
+	}
+	ctx.Params = params
+	return ctx
+}
+
+func Test_NewBinder(t *testing.T) {
+	t.Run("nil validator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binder, e := NewBinder(nil, NewMockParser(ctrl))
+		switch {
+		case binder != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil parser", func(t *testing.T) {
+		binder, e := NewBinder(func(interface{}, ...string) (*envelope.Envelope, error) { return nil, nil }, nil)
+		switch {
+		case binder != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("construct", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		binder, e := NewBinder(func(interface{}, ...string) (*envelope.Envelope, error) { return nil, nil }, NewMockParser(ctrl))
+		switch {
+		case binder == nil:
+			t.Error("didn't returned a valid reference")
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		}
+	})
+}
+
+func Test_Binder_Call(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if _, e := newTestBinder()(nil, &binderTestRequest{}); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil target", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+		if _, e := newTestBinder()(ctx, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("non structure pointer target", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+		if _, e := newTestBinder()(ctx, binderTestRequest{}); !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("bind all the request sources", func(t *testing.T) {
+		scenarios := []struct {
+			contentType string
+			body        string
+		}{
+			{ // json body
+				contentType: gin.MIMEJSON,
+				body:        `{"name":"john","age":30}`,
+			},
+			{ // xml body
+				contentType: gin.MIMEXML,
+				body:        `<request><name>john</name><age>30</age></request>`,
+			},
+			{ // form body
+				contentType: gin.MIMEPOSTForm,
+				body:        url.Values{"name": {"john"}, "age": {"30"}}.Encode(),
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.contentType, func(t *testing.T) {
+				ctx := newTestBinderContext(http.MethodPost, "/users/1?search=term", scenario.contentType, scenario.body, gin.Params{{Key: "id", Value: "1"}})
+				ctx.Request.Header.Set("X-Request-Id", "request")
+				target := &binderTestRequest{}
+
+				env, e := newTestBinder()(ctx, target)
+				switch {
+				case e != nil:
+					t.Errorf("returned the unexpected (%v) error", e)
+				case env != nil:
+					t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+				case *target != binderTestRequest{ID: 1, Search: "term", Request: "request", Name: "john", Age: 30}:
+					t.Errorf("bound the (%v) data", target)
+				}
+			})
+		}
+	})
+
+	t.Run("binding errors", func(t *testing.T) {
+		scenarios := []struct {
+			name        string
+			target      string
+			params      gin.Params
+			header      string
+			contentType string
+			body        string
+			expected    string
+		}{
+			{
+				name:     "invalid path parameter",
+				target:   "/users/abc",
+				params:   gin.Params{{Key: "id", Value: "abc"}},
+				expected: "c:116",
+			},
+			{
+				name:     "invalid query parameter",
+				target:   "/users?age=abc",
+				expected: "c:117",
+			},
+			{
+				name:        "invalid body",
+				target:      "/users",
+				contentType: gin.MIMEJSON,
+				body:        `{"name":`,
+				expected:    "c:119",
+			},
+		}
+
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				ctx := newTestBinderContext(http.MethodPost, scenario.target, scenario.contentType, scenario.body, scenario.params)
+
+				env, e := newTestBinder()(ctx, &binderTestRequest{})
+				switch {
+				case e != nil:
+					t.Errorf("returned the unexpected (%v) error", e)
+				case env == nil:
+					t.Error("didn't returned the error envelope")
+				case env.GetStatusCode() != http.StatusBadRequest:
+					t.Errorf("returned the (%v) status code", env.GetStatusCode())
+				case env.Status.Errors[0].GetCode() != scenario.expected:
+					t.Errorf("returned the (%v) error code when expecting (%v)", env.Status.Errors[0].GetCode(), scenario.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported body content type", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/users", "text/plain", "john", nil)
+
+		env, e := newTestBinder()(ctx, &binderTestRequest{})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		case env.GetStatusCode() != http.StatusUnsupportedMediaType:
+			t.Errorf("returned the (%v) status code", env.GetStatusCode())
+		case env.Status.Errors[0].GetCode() != "c:127":
+			t.Errorf("returned the (%v) error code", env.Status.Errors[0].GetCode())
+		}
+	})
+
+	t.Run("keep the query values on form body binding", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/users?search=term", gin.MIMEPOSTForm, url.Values{"name": {"john"}}.Encode(), nil)
+		target := &struct {
+			Search string `form:"search,default=all"`
+			Order  string `form:"order,default=asc"`
+			Name   string `form:"name,default=anonymous"`
+		}{}
+
+		env, e := newTestBinder()(ctx, target)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		case target.Search != "term":
+			t.Errorf("bound the (%v) query value when expecting (term)", target.Search)
+		case target.Order != "asc":
+			t.Errorf("bound the (%v) default value when expecting (asc)", target.Order)
+		case target.Name != "john":
+			t.Errorf("bound the (%v) body value when expecting (john)", target.Name)
+		}
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+		ctx.Request.Header.Set("X-Count", "abc")
+		target := &struct {
+			Count int `header:"X-Count"`
+		}{}
+
+		env, e := newTestBinder()(ctx, target)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		case env.Status.Errors[0].GetCode() != "c:118":
+			t.Errorf("returned the (%v) error code", env.Status.Errors[0].GetCode())
+		}
+	})
+
+	t.Run("body type error field", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{"name":"john","age":"old"}`, nil)
+
+		env, e := newTestBinder()(ctx, &binderTestRequest{})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		case env.Status.Errors[0].Field != "age":
+			t.Errorf("returned the (%v) error field", env.Status.Errors[0].Field)
+		case env.Status.Errors[0].GetMessage() != "invalid request body":
+			t.Errorf("returned the (%v) error message", env.Status.Errors[0].GetMessage())
+		}
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/users/0", gin.MIMEJSON, `{"age":30}`, gin.Params{{Key: "id", Value: "0"}})
+
+		env, e := newTestBinder()(ctx, &binderTestRequest{})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		case env.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", env.GetStatusCode())
+		case len(env.Status.Errors) != 2:
+			t.Errorf("returned the (%v) errors", env.Status.Errors)
+		case env.Status.Errors[0].GetCode() != "c:89" || env.Status.Errors[1].GetCode() != "c:104":
+			t.Errorf("returned the (%v) and (%v) error codes", env.Status.Errors[0].GetCode(), env.Status.Errors[1].GetCode())
+		}
+	})
+
+	t.Run("validation errors translated to the request language", func(t *testing.T) {
+		universal, _ := NewUniversalTranslator("en", "pt")
+		translator, _ := universal.GetTranslator("en")
+		validate := validator.New()
+		_ = RegisterTranslations(validate, universal)
+		localizer, _ := NewLocalizer(universal, translator)
+		parser, _ := NewParser(localizer)
+		v, _ := NewValidator(validate, parser)
+		binder, _ := NewBinder(v, parser)
+		ctx := newTestBinderContext(http.MethodPost, "/users/1", gin.MIMEJSON, `{"age":30}`, gin.Params{{Key: "id", Value: "1"}})
+		ctx.Request.Header.Set("Accept-Language", "de, pt-PT;q=0.9, en;q=0.5")
+
+		env, e := binder(ctx, &binderTestRequest{})
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		case env.Status.Errors[0].GetMessage() != "Name é obrigatório":
+			t.Errorf("returned the (%v) error message", env.Status.Errors[0].GetMessage())
+		}
+	})
+}
+
+func Test_Bind(t *testing.T) {
+	t.Run("nil binder", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+		if _, _, e := Bind[binderTestRequest](ctx, nil); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("binding error", func(t *testing.T) {
+		target, env, e := Bind[binderTestRequest](nil, newTestBinder())
+		switch {
+		case target != nil:
+			t.Error("returned a valid target reference")
+		case env != nil:
+			t.Error("returned a valid envelope reference")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{"age":30}`, gin.Params{{Key: "id", Value: "1"}})
+
+		target, env, e := Bind[binderTestRequest](ctx, newTestBinder())
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case target != nil:
+			t.Error("returned a valid target reference")
+		case env == nil:
+			t.Error("didn't returned the error envelope")
+		}
+	})
+
+	t.Run("bind request", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{"name":"john"}`, gin.Params{{Key: "id", Value: "1"}})
+
+		target, env, e := Bind[binderTestRequest](ctx, newTestBinder())
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		case target == nil || target.ID != 1 || target.Name != "john":
+			t.Errorf("bound the (%v) data", target)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+// ObserveCodes will load the parser error code overrides and the
+// unmapped error default code from the configuration, and register the
+// config observers that keep the parser updated with the configuration
+// changes. An invalid configuration change is discarded and logged,
+// keeping the last valid parser codes.
+func ObserveCodes(
+	parser IParser,
+	cfg config.IManager,
+	logger log.ILog,
+) error {
+	// check parser argument reference
+	if parser == nil {
+		return errNilPointer("parser")
+	}
+	// check config argument reference
+	if cfg == nil {
+		return errNilPointer("cfg")
+	}
+	// check logger argument reference
+	if logger == nil {
+		return errNilPointer("logger")
+	}
+	// validate log level
+	logLevel, ok := log.LevelMap[LogLevel]
+	if !ok {
+		logLevel = log.WARNING
+	}
+	// load the initial configured codes
+	codes, e := loadCodes(cfg)
+	if e != nil {
+		return e
+	}
+	defaultCode, e := cfg.Int(DefaultCodeConfigPath, DefaultCode)
+	if e != nil {
+		return e
+	}
+	parser.SetCodes(codes)
+	parser.SetDefaultCode(defaultCode)
+	// add a config observer for the error code overrides
+	_ = cfg.AddObserver(CodesConfigPath, func(old interface{}, new interface{}) {
+		codes, e := loadCodes(cfg)
+		if e != nil {
+			_ = logger.Signal(LogChannel, logLevel, LogCodesErrorMessage, log.Context{"error": e})
+			return
+		}
+		parser.SetCodes(codes)
+	})
+	// add a config observer for the unmapped error default code
+	_ = cfg.AddObserver(DefaultCodeConfigPath, func(old interface{}, new interface{}) {
+		defaultCode, e := cfg.Int(DefaultCodeConfigPath, DefaultCode)
+		if e != nil {
+			_ = logger.Signal(LogChannel, logLevel, LogCodesErrorMessage, log.Context{"error": e})
+			return
+		}
+		parser.SetDefaultCode(defaultCode)
+	})
+	return nil
+}
+
+func loadCodes(
+	cfg config.IConfig,
+) (map[string]int, error) {
+	// retrieve the error codes configuration block
+	block, e := cfg.Config(CodesConfigPath, config.Config{})
+	if e != nil {
+		return nil, e
+	}
+	// parse all the block entries, discarding the whole
+	// block if any entry is not an integer
+	codes := map[string]int{}
+	for _, tag := range block.Entries() {
+		code, e := block.Int(tag)
+		if e != nil {
+			return nil, e
+		}
+		codes[tag] = code
+	}
+	return codes, nil
+}
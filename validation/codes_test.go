@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+func Test_ObserveCodes(t *testing.T) {
+	t.Run("nil parser", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveCodes(nil, NewMockConfigManager(ctrl), NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveCodes(NewMockParser(ctrl), nil, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveCodes(NewMockParser(ctrl), NewMockConfigManager(ctrl), nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("error retrieving the codes configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(nil, expected).Times(1)
+
+		if e := ObserveCodes(NewMockParser(ctrl), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if e.Error() != expected.Error() {
+			t.Errorf("returned (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("invalid code configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"required": "string"}, nil).Times(1)
+
+		if e := ObserveCodes(NewMockParser(ctrl), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving the default code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{}, nil).Times(1)
+		cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(0, expected).Times(1)
+
+		if e := ObserveCodes(NewMockParser(ctrl), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if e.Error() != expected.Error() {
+			t.Errorf("returned (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("load the configured codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"required": 500, "even": 1000}, nil).Times(1)
+		cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(999, nil).Times(1)
+		cfg.EXPECT().AddObserver(CodesConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().AddObserver(DefaultCodeConfigPath, gomock.Any()).Return(nil).Times(1)
+		parser := NewMockParser(ctrl)
+		parser.EXPECT().SetCodes(map[string]int{"required": 500, "even": 1000}).Times(1)
+		parser.EXPECT().SetDefaultCode(999).Times(1)
+
+		if e := ObserveCodes(parser, cfg, NewMockLog(ctrl)); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		}
+	})
+
+	t.Run("reload the codes on configuration changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var codesObserver, defaultObserver config.IObserver
+		cfg := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"required": 500}, nil),
+			cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"required": "string"}, nil),
+			cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"required": 501}, nil),
+		)
+		gomock.InOrder(
+			cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(999, nil),
+			cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(0, fmt.Errorf("error message")),
+			cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(998, nil),
+		)
+		cfg.EXPECT().AddObserver(CodesConfigPath, gomock.Any()).DoAndReturn(func(_ string, o config.IObserver) error {
+			codesObserver = o
+			return nil
+		}).Times(1)
+		cfg.EXPECT().AddObserver(DefaultCodeConfigPath, gomock.Any()).DoAndReturn(func(_ string, o config.IObserver) error {
+			defaultObserver = o
+			return nil
+		}).Times(1)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.WARNING, LogCodesErrorMessage, gomock.Any()).Return(nil).Times(2)
+		sut, _ := NewParser(staticLocalizer(nil))
+
+		_ = ObserveCodes(sut, cfg, logger)
+		codesObserver(nil, nil)
+		defaultObserver(nil, nil)
+		if check := sut.Codes()["required"]; check != 500 {
+			t.Errorf("stored the (%v) code after an invalid change", check)
+		} else if check := sut.DefaultCode(); check != 999 {
+			t.Errorf("stored the (%v) default code after an invalid change", check)
+		}
+
+		codesObserver(nil, nil)
+		defaultObserver(nil, nil)
+		if check := sut.Codes()["required"]; check != 501 {
+			t.Errorf("stored the (%v) code when expecting (501)", check)
+		} else if check := sut.DefaultCode(); check != 998 {
+			t.Errorf("stored the (%v) default code when expecting (998)", check)
+		}
+	})
+}
+
+func Test_loadCodes(t *testing.T) {
+	t.Run("empty configuration", func(t *testing.T) {
+		cfg := config.Config{}
+
+		if codes, e := loadCodes(cfg); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if !reflect.DeepEqual(codes, map[string]int{}) {
+			t.Errorf("returned the unexpected (%v) codes", codes)
+		}
+	})
+}
@@ -14,4 +14,79 @@ var (
 	// Locale defines the default locale string to be used when
 	// instantiating the translator.
 	Locale = env.String(EnvID+"_LOCALE", "en")
+
+	// Locales defines the comma separated list of locales supported by
+	// the universal translator, and selectable by the request
+	// Accept-Language header.
+	Locales = env.String(EnvID+"_LOCALES", "en,es,fr,it,nl,pt,pt_BR")
+
+	// CodesConfigPath defines the config path of the validation error
+	// to code mapping that overrides and extends the parser built-in
+	// error codes.
+	CodesConfigPath = env.String(EnvID+"_CODES_CONFIG_PATH", "slate.rest.validation.codes")
+
+	// DefaultCodeConfigPath defines the config path of the error code
+	// assigned to the validation errors without a mapped code.
+	DefaultCodeConfigPath = env.String(EnvID+"_DEFAULT_CODE_CONFIG_PATH", "slate.rest.validation.default_code")
+
+	// DefaultCode defines the error code assigned to the validation
+	// errors without a mapped code, if not defined in the configuration.
+	// The default value is kept outside the parser mapped codes range,
+	// so unmapped errors are distinguishable from the mapped ones.
+	DefaultCode = env.Int(EnvID+"_DEFAULT_CODE", 999)
+
+	// SchemasConfigPath defines the config path of the endpoint id to
+	// request body JSON Schema mapping, where each schema is defined
+	// inline or by the path of the schema document file.
+	SchemasConfigPath = env.String(EnvID+"_SCHEMAS_CONFIG_PATH", "slate.rest.validation.schemas")
+
+	// ResponseSchemasConfigPath defines the config path of the endpoint
+	// id to response data JSON Schema mapping, used by the response
+	// validation middleware.
+	ResponseSchemasConfigPath = env.String(EnvID+"_RESPONSE_SCHEMAS_CONFIG_PATH", "slate.rest.validation.response_schemas")
+
+	// ResponseValidation flag that defines if the endpoints response data
+	// should be validated by the response validation middleware. The
+	// responses are never validated when gin is running in release mode.
+	ResponseValidation = env.Bool(EnvID+"_RESPONSE_VALIDATION", false)
+
+	// ResponseValidationFail flag that defines if an invalid response
+	// should be replaced by an internal server error envelope, instead of
+	// only logging the response violations.
+	ResponseValidationFail = env.Bool(EnvID+"_RESPONSE_VALIDATION_FAIL", false)
+
+	// LogLevel defines the logging level of the request validation
+	// errors, of the response validation violations and of the discarded
+	// configuration reloads.
+	LogLevel = env.String(EnvID+"_LOG_LEVEL", "warning")
+
+	// LogChannel defines the logging channel of the request validation
+	// errors, of the response validation violations and of the discarded
+	// configuration reloads.
+	LogChannel = env.String(EnvID+"_LOG_CHANNEL", "rest")
+
+	// LogCodesErrorMessage defines the logging message of a discarded
+	// error codes configuration reload.
+	LogCodesErrorMessage = env.String(EnvID+"_LOG_CODES_ERROR_MESSAGE", "Invalid validation codes reload")
+
+	// LogSchemasErrorMessage defines the logging message of a discarded
+	// JSON Schemas configuration reload.
+	LogSchemasErrorMessage = env.String(EnvID+"_LOG_SCHEMAS_ERROR_MESSAGE", "Invalid validation schemas reload")
+
+	// LogRequestErrorMessage defines the logging message of a request
+	// binding or schema validation error written as a generic internal
+	// server error response.
+	LogRequestErrorMessage = env.String(EnvID+"_LOG_REQUEST_ERROR_MESSAGE", "Request validation error")
+
+	// LogResponseErrorMessage defines the logging message of an invalid
+	// endpoint response data.
+	LogResponseErrorMessage = env.String(EnvID+"_LOG_RESPONSE_ERROR_MESSAGE", "Invalid endpoint response")
+
+	// PaginationCount defines the page size assigned to the pagination
+	// parameters if not present in the request.
+	PaginationCount = env.Int(EnvID+"_PAGINATION_COUNT", 20)
+
+	// PaginationMaxCount defines the maximum page size accepted by the
+	// pagination parameters.
+	PaginationMaxCount = env.Int(EnvID+"_PAGINATION_MAX_COUNT", 100)
 )
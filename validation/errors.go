@@ -9,6 +9,26 @@ import (
 var (
 	// ErrTranslatorNotFound @todo doc
 	ErrTranslatorNotFound = fmt.Errorf("translator not found")
+
+	// ErrInvalidRule defines an error that signal that a custom
+	// validation rule could not be registered.
+	ErrInvalidRule = fmt.Errorf("invalid validation rule")
+
+	// ErrInvalidParam defines an error that signal that a request
+	// parameter schema is invalid.
+	ErrInvalidParam = fmt.Errorf("invalid parameter schema")
+
+	// ErrInvalidSchema defines an error that signal that a JSON Schema
+	// document is invalid.
+	ErrInvalidSchema = fmt.Errorf("invalid JSON schema")
+
+	// ErrSchemaNotFound defines an error that signal that there is no
+	// JSON Schema registered for an endpoint.
+	ErrSchemaNotFound = fmt.Errorf("JSON schema not found")
+
+	// ErrUnsupportedMediaType defines an error that signal that the
+	// request body content type can't be bound.
+	ErrUnsupportedMediaType = fmt.Errorf("unsupported media type")
 )
 
 func errNilPointer(
@@ -32,3 +52,38 @@ func errTranslatorNotFound(
 ) error {
 	return slate.NewErrorFrom(ErrTranslatorNotFound, translator, ctx...)
 }
+
+func errInvalidRule(
+	tag string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidRule, tag, ctx...)
+}
+
+func errInvalidParam(
+	name string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidParam, name, ctx...)
+}
+
+func errInvalidSchema(
+	schema string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrInvalidSchema, schema, ctx...)
+}
+
+func errSchemaNotFound(
+	id string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrSchemaNotFound, id, ctx...)
+}
+
+func errUnsupportedMediaType(
+	contentType string,
+	ctx ...map[string]interface{},
+) error {
+	return slate.NewErrorFrom(ErrUnsupportedMediaType, contentType, ctx...)
+}
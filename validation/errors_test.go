@@ -98,3 +98,123 @@ func Test_errTranslatorNotFound(t *testing.T) {
 		}
 	})
 }
+
+func Test_errInvalidRule(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : invalid validation rule"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidRule(arg); !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("error not a instance of ErrInvalidRule")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidRule(arg, context); !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("error not a instance of ErrInvalidRule")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errInvalidParam(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : invalid parameter schema"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidParam(arg); !errors.Is(e, ErrInvalidParam) {
+			t.Errorf("error not a instance of ErrInvalidParam")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidParam(arg, context); !errors.Is(e, ErrInvalidParam) {
+			t.Errorf("error not a instance of ErrInvalidParam")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errInvalidSchema(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : invalid JSON schema"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errInvalidSchema(arg); !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("error not a instance of ErrInvalidSchema")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errInvalidSchema(arg, context); !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("error not a instance of ErrInvalidSchema")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
+
+func Test_errSchemaNotFound(t *testing.T) {
+	arg := "dummy argument"
+	context := map[string]interface{}{"field": "value"}
+	message := "dummy argument : JSON schema not found"
+
+	t.Run("creation without context", func(t *testing.T) {
+		if e := errSchemaNotFound(arg); !errors.Is(e, ErrSchemaNotFound) {
+			t.Errorf("error not a instance of ErrSchemaNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if te.Context() != nil {
+			t.Errorf("didn't stored a nil value context")
+		}
+	})
+
+	t.Run("creation with context", func(t *testing.T) {
+		if e := errSchemaNotFound(arg, context); !errors.Is(e, ErrSchemaNotFound) {
+			t.Errorf("error not a instance of ErrSchemaNotFound")
+		} else if e.Error() != message {
+			t.Errorf("error message (%v) not same as expected (%v)", e, message)
+		} else if te, ok := e.(slate.IError); !ok {
+			t.Errorf("didn't returned a slate error instance")
+		} else if check := te.Context(); !reflect.DeepEqual(check, context) {
+			t.Errorf("context (%v) not same as expected (%v)", check, context)
+		}
+	})
+}
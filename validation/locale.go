@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/it"
+	"github.com/go-playground/locales/nl"
+	"github.com/go-playground/locales/pt"
+	"github.com/go-playground/locales/pt_BR"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	esTranslations "github.com/go-playground/validator/v10/translations/es"
+	frTranslations "github.com/go-playground/validator/v10/translations/fr"
+	itTranslations "github.com/go-playground/validator/v10/translations/it"
+	nlTranslations "github.com/go-playground/validator/v10/translations/nl"
+	ptTranslations "github.com/go-playground/validator/v10/translations/pt"
+	ptBRTranslations "github.com/go-playground/validator/v10/translations/pt_BR"
+)
+
+type localeSupport struct {
+	translator   func() locales.Translator
+	translations func(*validator.Validate, ut.Translator) error
+}
+
+// supportedLocales defines the locales that can be registered in the
+// universal translator, along with the validation messages registration
+// function of the locale.
+var supportedLocales = map[string]localeSupport{
+	"en":    {en.New, enTranslations.RegisterDefaultTranslations},
+	"es":    {es.New, esTranslations.RegisterDefaultTranslations},
+	"fr":    {fr.New, frTranslations.RegisterDefaultTranslations},
+	"it":    {it.New, itTranslations.RegisterDefaultTranslations},
+	"nl":    {nl.New, nlTranslations.RegisterDefaultTranslations},
+	"pt":    {pt.New, ptTranslations.RegisterDefaultTranslations},
+	"pt_BR": {pt_BR.New, ptBRTranslations.RegisterDefaultTranslations},
+}
+
+// Localizer is a function type used to define a calling interface of
+// function responsible to select the translator of the first supported
+// locale of a requested locale list
+type Localizer func(locale ...string) ut.Translator
+
+// NewLocalizer instantiates a new translator selection function.
+// The requested locales are searched in order, falling back to the locale
+// base language and to the given default translator.
+func NewLocalizer(
+	universalTranslator *ut.UniversalTranslator,
+	translator ut.Translator,
+) (Localizer, error) {
+	// check universal translator argument reference
+	if universalTranslator == nil {
+		return nil, errNilPointer("universalTranslator")
+	}
+	// check translator argument reference
+	if translator == nil {
+		return nil, errNilPointer("translator")
+	}
+	// return the translator selection method instance
+	return func(locale ...string) ut.Translator {
+		for _, l := range locale {
+			l = strings.ReplaceAll(l, "-", "_")
+			if t, found := universalTranslator.GetTranslator(l); found {
+				return t
+			}
+			if t, found := universalTranslator.GetTranslator(strings.Split(l, "_")[0]); found {
+				return t
+			}
+		}
+		return translator
+	}, nil
+}
+
+// NewUniversalTranslator instantiates a new universal translator with
+// the given default locale and the list of extra supported locales.
+func NewUniversalTranslator(
+	locale string,
+	supported ...string,
+) (*ut.UniversalTranslator, error) {
+	// retrieve the default locale translator
+	fallback, ok := supportedLocales[locale]
+	if !ok {
+		return nil, errTranslatorNotFound(locale)
+	}
+	// compose the list of supported locale translators
+	translators := []locales.Translator{fallback.translator()}
+	for _, l := range supported {
+		if l = strings.TrimSpace(l); l == "" || l == locale {
+			continue
+		}
+		s, ok := supportedLocales[l]
+		if !ok {
+			return nil, errTranslatorNotFound(l)
+		}
+		translators = append(translators, s.translator())
+	}
+	return ut.New(translators[0], translators...), nil
+}
+
+// RegisterTranslations will register the validation messages
+// translations of all the supported locales of the universal translator
+// in the given validate instance.
+func RegisterTranslations(
+	validate *validator.Validate,
+	universalTranslator *ut.UniversalTranslator,
+) error {
+	// check validate argument reference
+	if validate == nil {
+		return errNilPointer("validate")
+	}
+	// check universal translator argument reference
+	if universalTranslator == nil {
+		return errNilPointer("universalTranslator")
+	}
+	// register the translations of the locales found in the translator
+	for l, s := range supportedLocales {
+		if translator, found := universalTranslator.GetTranslator(l); found {
+			if e := s.translations(validate, translator); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// AcceptLanguages will parse the request Accept-Language header into
+// the list of requested locales ordered by the given quality weights.
+func AcceptLanguages(
+	ctx *gin.Context,
+) []string {
+	// check the context argument reference
+	if ctx == nil || ctx.Request == nil {
+		return nil
+	}
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	// parse the header comma separated values
+	var list []weighted
+	for _, value := range strings.Split(ctx.GetHeader("Accept-Language"), ",") {
+		parts := strings.Split(value, ";")
+		locale := strings.TrimSpace(parts[0])
+		if locale == "" || locale == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range parts[1:] {
+			if param = strings.TrimSpace(param); strings.HasPrefix(param, "q=") {
+				if f, e := strconv.ParseFloat(param[2:], 64); e == nil {
+					q = f
+				}
+			}
+		}
+		if q > 0 {
+			list = append(list, weighted{locale: locale, q: q})
+		}
+	}
+	// order the locales by the quality weight
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].q > list[j].q
+	})
+	var result []string
+	for _, w := range list {
+		result = append(result, w.locale)
+	}
+	return result
+}
@@ -0,0 +1,257 @@
+package validation
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/pt"
+	"github.com/go-playground/locales/pt_BR"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+)
+
+func Test_NewLocalizer(t *testing.T) {
+	t.Run("nil universal translator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		localizer, e := NewLocalizer(nil, NewMockTranslator(ctrl))
+		switch {
+		case localizer != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil translator", func(t *testing.T) {
+		lang := en.New()
+		localizer, e := NewLocalizer(ut.New(lang, lang), nil)
+		switch {
+		case localizer != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("new localizer", func(t *testing.T) {
+		lang := en.New()
+		universal := ut.New(lang, lang)
+		translator, _ := universal.GetTranslator("en")
+
+		if localizer, e := NewLocalizer(universal, translator); e != nil {
+			t.Errorf("return the (%v) error", e)
+		} else if localizer == nil {
+			t.Error("didn't returned a valid reference")
+		}
+	})
+}
+
+func Test_Localizer_Call(t *testing.T) {
+	universal := ut.New(en.New(), en.New(), pt.New(), pt_BR.New())
+	translator, _ := universal.GetTranslator("en")
+	localizer, _ := NewLocalizer(universal, translator)
+
+	scenarios := []struct {
+		test     string
+		locale   []string
+		expected string
+	}{
+		{ // no locale
+			test:     "no locale",
+			locale:   nil,
+			expected: "en",
+		},
+		{ // exact locale
+			test:     "exact locale",
+			locale:   []string{"pt"},
+			expected: "pt",
+		},
+		{ // region locale with hyphen separator
+			test:     "region locale with hyphen separator",
+			locale:   []string{"pt-BR"},
+			expected: "pt_BR",
+		},
+		{ // region locale fallback to base language
+			test:     "region locale fallback to base language",
+			locale:   []string{"pt-PT"},
+			expected: "pt",
+		},
+		{ // first supported locale of the list
+			test:     "first supported locale of the list",
+			locale:   []string{"de", "fr-FR", "pt"},
+			expected: "pt",
+		},
+		{ // unsupported locale fallback to default
+			test:     "unsupported locale fallback to default",
+			locale:   []string{"de", "fr"},
+			expected: "en",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			if check := localizer(s.locale...).Locale(); check != s.expected {
+				t.Errorf("selected the (%v) locale when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_NewUniversalTranslator(t *testing.T) {
+	t.Run("unsupported default locale", func(t *testing.T) {
+		translator, e := NewUniversalTranslator("unsupported")
+		switch {
+		case translator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrTranslatorNotFound):
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrTranslatorNotFound)
+		}
+	})
+
+	t.Run("unsupported locale", func(t *testing.T) {
+		translator, e := NewUniversalTranslator("en", "pt", "unsupported")
+		switch {
+		case translator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, ErrTranslatorNotFound):
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrTranslatorNotFound)
+		}
+	})
+
+	t.Run("new universal translator", func(t *testing.T) {
+		translator, e := NewUniversalTranslator("pt", "en", " es ", "", "pt")
+		switch {
+		case e != nil:
+			t.Errorf("return the (%v) error", e)
+		case translator == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			for _, locale := range []string{"pt", "en", "es"} {
+				if _, found := translator.GetTranslator(locale); !found {
+					t.Errorf("didn't registered the (%v) locale", locale)
+				}
+			}
+			if _, found := translator.GetTranslator("fr"); found {
+				t.Error("registered the unexpected (fr) locale")
+			}
+			if check := translator.GetFallback().Locale(); check != "pt" {
+				t.Errorf("stored the (%v) fallback locale when expecting (pt)", check)
+			}
+		}
+	})
+}
+
+func Test_RegisterTranslations(t *testing.T) {
+	t.Run("nil validate", func(t *testing.T) {
+		universal, _ := NewUniversalTranslator("en")
+
+		if e := RegisterTranslations(nil, universal); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil universal translator", func(t *testing.T) {
+		if e := RegisterTranslations(validator.New(), nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("register the translator locales messages", func(t *testing.T) {
+		universal, _ := NewUniversalTranslator("en", "es")
+		validate := validator.New()
+		data := struct {
+			Field string `validate:"required"`
+		}{}
+		expected := map[string]string{
+			"en": "Field is a required field",
+			"es": "Field es un campo requerido",
+		}
+
+		if e := RegisterTranslations(validate, universal); e != nil {
+			t.Errorf("return the (%v) error", e)
+		} else {
+			errs := validate.Struct(data).(validator.ValidationErrors)
+			for locale, message := range expected {
+				translator, _ := universal.GetTranslator(locale)
+				if check := errs[0].Translate(translator); check != message {
+					t.Errorf("translated to (%v) when expecting (%v)", check, message)
+				}
+			}
+		}
+	})
+}
+
+func Test_AcceptLanguages(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if check := AcceptLanguages(nil); check != nil {
+			t.Errorf("returned the unexpected (%v) list", check)
+		}
+	})
+
+	scenarios := []struct {
+		test     string
+		header   string
+		expected []string
+	}{
+		{ // no header
+			test:     "no header",
+			header:   "",
+			expected: nil,
+		},
+		{ // single locale
+			test:     "single locale",
+			header:   "pt-PT",
+			expected: []string{"pt-PT"},
+		},
+		{ // locales ordered by quality
+			test:     "locales ordered by quality",
+			header:   "en;q=0.5, pt-PT, fr;q=0.8, es",
+			expected: []string{"pt-PT", "es", "fr", "en"},
+		},
+		{ // discard wildcard and zero quality locales
+			test:     "discard wildcard and zero quality locales",
+			header:   "*, de;q=0, es;q=0.1",
+			expected: []string{"es"},
+		},
+		{ // ignore invalid quality values
+			test:     "ignore invalid quality values",
+			header:   "es;q=0.5, fr;q=invalid",
+			expected: []string{"fr", "es"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			gin.SetMode(gin.ReleaseMode)
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest("GET", "/", nil)
+			if s.header != "" {
+				ctx.Request.Header.Set("Accept-Language", s.header)
+			}
+
+			if check := AcceptLanguages(ctx); !reflect.DeepEqual(check, s.expected) {
+				t.Errorf("returned the (%v) list when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+const (
+	// ModelContextKey defines the gin context key used to store the
+	// request model bound and validated by the validation middleware.
+	ModelContextKey = ID + ".model"
+)
+
+// MiddlewareGenerator is a function type used to define a calling
+// interface of function responsible to generate an endpoint request
+// validation middleware, that binds and validates the request into a
+// new instance of the given request model structure type.
+type MiddlewareGenerator func(id string, model interface{}) (rest.Middleware, error)
+
+// NewMiddlewareGenerator returns a validation middleware generator
+// function. The generated middleware validates the request body against
+// the JSON Schema of the endpoint, if one is registered, then binds and
+// validates the request into a new model instance. On failure the
+// handler is not called and, if the middleware is chained after the
+// envelope middleware, the error envelope is stored as the endpoint
+// response to be rendered by it, otherwise the error envelope is written
+// directly as a JSON response, with the binding and schema validation
+// errors logged and hidden behind a generic internal server error. On
+// success the model is stored in the context to be retrieved by the
+// handler with the Model function.
+func NewMiddlewareGenerator(
+	binder Binder,
+	schemaValidator SchemaValidator,
+	logger log.ILog,
+) (MiddlewareGenerator, error) {
+	// check binder argument reference
+	if binder == nil {
+		return nil, errNilPointer("binder")
+	}
+	// check schema validator argument reference
+	if schemaValidator == nil {
+		return nil, errNilPointer("schemaValidator")
+	}
+	// check logger argument reference
+	if logger == nil {
+		return nil, errNilPointer("logger")
+	}
+	// validate log level
+	logLevel, ok := log.LevelMap[LogLevel]
+	if !ok {
+		logLevel = log.WARNING
+	}
+	// return the middleware generator
+	return func(
+		id string,
+		model interface{},
+	) (rest.Middleware, error) {
+		// discover the request model structure type
+		t := reflect.TypeOf(model)
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return nil, errConversion(model, "struct")
+		}
+		// return the generated middleware function
+		return func(
+			next gin.HandlerFunc,
+		) gin.HandlerFunc {
+			// return the middleware handler function
+			return func(
+				ctx *gin.Context,
+			) {
+				// validate the request body against the endpoint schema,
+				// if the endpoint has a registered schema
+				env, e := schemaValidator(ctx, id)
+				if errors.Is(e, ErrSchemaNotFound) {
+					env, e = nil, nil
+				}
+				// bind and validate the request model
+				if env == nil && e == nil {
+					target := reflect.New(t).Interface()
+					if env, e = binder(ctx, target); env == nil && e == nil {
+						ctx.Set(ModelContextKey, target)
+						next(ctx)
+						return
+					}
+				}
+				// short-circuit the request with the validation response,
+				// delegating the rendering to the envelope middleware
+				// if the request is being enveloped
+				if _, ok := ctx.Get(rest.EnvelopedContextKey); ok {
+					if e != nil {
+						ctx.Set("response", e)
+					} else {
+						ctx.Set("response", env)
+					}
+					ctx.Abort()
+					return
+				}
+				// write the error envelope, logging and hiding the raw
+				// errors behind a generic internal server error
+				if e != nil {
+					_ = logger.Signal(LogChannel, logLevel, LogRequestErrorMessage, log.Context{"endpoint": id, "error": e})
+					env = envelope.NewEnvelope(http.StatusInternalServerError, nil).
+						AddError(envelope.NewStatusError(0, "internal server error"))
+				}
+				ctx.AbortWithStatusJSON(env.GetStatusCode(), env)
+			}
+		}, nil
+	}, nil
+}
+
+// Model will retrieve the request model stored in the context by the
+// validation middleware.
+func Model[T any](
+	ctx *gin.Context,
+) (*T, bool) {
+	// check the context argument reference
+	if ctx == nil {
+		return nil, false
+	}
+	// retrieve and type check the stored model
+	entry, ok := ctx.Get(ModelContextKey)
+	if !ok {
+		return nil, false
+	}
+	model, ok := entry.(*T)
+	return model, ok
+}
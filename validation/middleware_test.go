@@ -0,0 +1,306 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+type middlewareTestRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func newTestMiddlewareGenerator(
+	logger log.ILog,
+	schema string,
+) MiddlewareGenerator {
+	validator := newTestSchemaValidator(schema)
+	generator, _ := NewMiddlewareGenerator(newTestBinder(), validator, logger)
+	return generator
+}
+
+func Test_NewMiddlewareGenerator(t *testing.T) {
+	t.Run("nil binder", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		generator, e := NewMiddlewareGenerator(nil, newTestSchemaValidator(`{}`), NewMockLog(ctrl))
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil schema validator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		generator, e := NewMiddlewareGenerator(newTestBinder(), nil, NewMockLog(ctrl))
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		generator, e := NewMiddlewareGenerator(newTestBinder(), newTestSchemaValidator(`{}`), nil)
+		switch {
+		case generator != nil:
+			t.Error("returned a valid reference")
+		case e == nil:
+			t.Error("didn't returned the expected error")
+		case !errors.Is(e, slate.ErrNilPointer):
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("non structure model", func(t *testing.T) {
+		scenarios := []interface{}{nil, "string", (*int)(nil)}
+
+		for _, model := range scenarios {
+			t.Run(fmt.Sprintf("%T", model), func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				if _, e := newTestMiddlewareGenerator(NewMockLog(ctrl), `{}`)("endpoint", model); !errors.Is(e, slate.ErrConversion) {
+					t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+				}
+			})
+		}
+	})
+
+	t.Run("store the validated model", func(t *testing.T) {
+		scenarios := []struct {
+			test   string
+			model  interface{}
+			schema string
+		}{
+			{ // structure model without schema
+				test:   "structure model without schema",
+				model:  middlewareTestRequest{},
+				schema: `invalid`,
+			},
+			{ // structure pointer model with schema
+				test:   "structure pointer model with schema",
+				model:  &middlewareTestRequest{},
+				schema: `{"required":["name"]}`,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{"name":"john"}`, nil)
+				mw, _ := newTestMiddlewareGenerator(NewMockLog(ctrl), s.schema)("endpoint", s.model)
+
+				var model *middlewareTestRequest
+				var found bool
+				mw(func(ctx *gin.Context) {
+					model, found = Model[middlewareTestRequest](ctx)
+				})(ctx)
+
+				switch {
+				case !found:
+					t.Error("didn't stored the request model")
+				case model.Name != "john":
+					t.Errorf("stored the (%v) model", model)
+				case ctx.IsAborted():
+					t.Error("unexpectedly aborted the request")
+				}
+			})
+		}
+	})
+
+	t.Run("short-circuit the request on failure", func(t *testing.T) {
+		scenarios := []struct {
+			test   string
+			schema string
+			body   string
+			code   string
+		}{
+			{ // schema violation
+				test:   "schema violation",
+				schema: `{"required":["name"]}`,
+				body:   `{}`,
+				code:   "c:104",
+			},
+			{ // binding error
+				test:   "binding error",
+				schema: `invalid`,
+				body:   `{"name":1}`,
+				code:   "c:119",
+			},
+			{ // validation error
+				test:   "validation error",
+				schema: `invalid`,
+				body:   `{}`,
+				code:   "c:104",
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, s.body, nil)
+				ctx.Set(rest.EnvelopedContextKey, true)
+				mw, _ := newTestMiddlewareGenerator(NewMockLog(ctrl), s.schema)("endpoint", middlewareTestRequest{})
+
+				called := false
+				mw(func(*gin.Context) { called = true })(ctx)
+
+				response, _ := ctx.Get("response")
+				env, ok := response.(*envelope.Envelope)
+				switch {
+				case called:
+					t.Error("unexpectedly called the handler")
+				case !ctx.IsAborted():
+					t.Error("didn't aborted the request")
+				case !ok:
+					t.Errorf("stored the (%v) response", response)
+				case env.GetStatusCode() != http.StatusBadRequest:
+					t.Errorf("stored the (%v) status code", env.GetStatusCode())
+				case env.Status.Errors[0].GetCode() != s.code:
+					t.Errorf("stored the (%v) error code when expecting (%v)", env.Status.Errors[0].GetCode(), s.code)
+				}
+			})
+		}
+	})
+
+	t.Run("short-circuit the request on error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{}`, nil)
+		ctx.Set(rest.EnvelopedContextKey, true)
+		ctx.Request.Body = io.NopCloser(iotest.ErrReader(expected))
+		mw, _ := newTestMiddlewareGenerator(NewMockLog(ctrl), `{}`)("endpoint", middlewareTestRequest{})
+
+		called := false
+		mw(func(*gin.Context) { called = true })(ctx)
+
+		response, _ := ctx.Get("response")
+		e, ok := response.(error)
+		switch {
+		case called:
+			t.Error("unexpectedly called the handler")
+		case !ok:
+			t.Errorf("stored the (%v) response", response)
+		case !errors.Is(e, expected):
+			t.Errorf("stored the (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("write the failure response if not enveloped", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			body     io.Reader
+			logged   bool
+			status   int
+			expected string
+		}{
+			{ // validation error
+				test:     "validation error",
+				body:     strings.NewReader(`{}`),
+				status:   http.StatusBadRequest,
+				expected: `{"status":{"success":false,"error":[{"code":"c:104","message":"Name is a required field","field":"name"}]}}`,
+			},
+			{ // request error
+				test:     "request error",
+				body:     iotest.ErrReader(fmt.Errorf("error message")),
+				logged:   true,
+				status:   http.StatusInternalServerError,
+				expected: `{"status":{"success":false,"error":[{"code":"c:0","message":"internal server error"}]}}`,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				logger := NewMockLog(ctrl)
+				if s.logged {
+					logger.EXPECT().Signal(LogChannel, log.WARNING, LogRequestErrorMessage, gomock.Any()).DoAndReturn(
+						func(_ string, _ log.Level, _ string, ctx ...log.Context) error {
+							if ctx[0]["endpoint"] != "endpoint" || ctx[0]["error"] == nil {
+								t.Errorf("logged the unexpected (%v) context", ctx[0])
+							}
+							return nil
+						}).Times(1)
+				}
+				writer := httptest.NewRecorder()
+				ctx, _ := gin.CreateTestContext(writer)
+				ctx.Request = newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{}`, nil).Request
+				ctx.Request.Body = io.NopCloser(s.body)
+				mw, _ := newTestMiddlewareGenerator(logger, `{}`)("endpoint", middlewareTestRequest{})
+
+				called := false
+				mw(func(*gin.Context) { called = true })(ctx)
+
+				_, stored := ctx.Get("response")
+				switch {
+				case called:
+					t.Error("unexpectedly called the handler")
+				case !ctx.IsAborted():
+					t.Error("didn't aborted the request")
+				case stored:
+					t.Error("unexpectedly stored the response")
+				case writer.Code != s.status:
+					t.Errorf("responded with the (%v) status code when expecting (%v)", writer.Code, s.status)
+				case writer.Body.String() != s.expected:
+					t.Errorf("responded with (%v) when expecting (%v)", writer.Body.String(), s.expected)
+				}
+			})
+		}
+	})
+}
+
+func Test_Model(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if _, found := Model[middlewareTestRequest](nil); found {
+			t.Error("unexpectedly found the model")
+		}
+	})
+
+	t.Run("missing model", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+		if _, found := Model[middlewareTestRequest](ctx); found {
+			t.Error("unexpectedly found the model")
+		}
+	})
+
+	t.Run("model type mismatch", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+		ctx.Set(ModelContextKey, &binderTestRequest{})
+
+		if _, found := Model[middlewareTestRequest](ctx); found {
+			t.Error("unexpectedly found the model")
+		}
+	})
+}
@@ -0,0 +1,189 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+)
+
+type namespaceStep struct {
+	name    string
+	indexes []string
+}
+
+// parseNamespace will split a validation error structure namespace
+// (ex: User.Addresses[0].Street) into the list of field steps, each
+// holding the field name and the list of slice/map element indexes
+// applied to the field value.
+func parseNamespace(
+	namespace string,
+) []namespaceStep {
+	var steps []namespaceStep
+	step := namespaceStep{}
+	flush := func() {
+		if step.name != "" || len(step.indexes) != 0 {
+			steps = append(steps, step)
+		}
+		step = namespaceStep{}
+	}
+	for i := 0; i < len(namespace); i++ {
+		switch namespace[i] {
+		case '.':
+			flush()
+		case '[':
+			// the element index ends with the first closing bracket
+			end := strings.IndexByte(namespace[i:], ']')
+			if end == -1 {
+				end = len(namespace) - i
+			}
+			step.indexes = append(step.indexes, namespace[i+1:i+end])
+			i += end
+		default:
+			step.name += string(namespace[i])
+		}
+	}
+	flush()
+	return steps
+}
+
+// resolveNamespace will search the structure field referenced by the
+// given validation error structure namespace, traversing pointers,
+// embedded structures, slices, arrays and maps, and return the found
+// field along with the JSON path of the failing element.
+func resolveNamespace(
+	t reflect.Type,
+	namespace string,
+) (reflect.StructField, string, bool) {
+	steps := parseNamespace(namespace)
+	// discard the root structure name prefix
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Name() != "" && len(steps) > 1 && steps[0].name == t.Name() && len(steps[0].indexes) == 0 {
+		steps = steps[1:]
+	}
+	var field reflect.StructField
+	var path []string
+	for _, step := range steps {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return reflect.StructField{}, "", false
+		}
+		var ok bool
+		if field, ok = t.FieldByName(step.name); !ok {
+			return reflect.StructField{}, "", false
+		}
+		// compose the JSON path of the field
+		segment := jsonName(field)
+		for _, index := range step.indexes {
+			segment += "[" + index + "]"
+		}
+		if segment != "" {
+			path = append(path, segment)
+		}
+		// traverse the field value type into the indexed element type
+		t = field.Type
+		for range step.indexes {
+			for t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				t = t.Elem()
+			}
+		}
+	}
+	if len(steps) == 0 {
+		return reflect.StructField{}, "", false
+	}
+	return field, strings.Join(path, "."), true
+}
+
+// resolveSibling will compose the JSON path of the named field of the
+// structure that holds the field referenced by the given namespace. The
+// name is appended to the structure path if not found in the structure.
+func resolveSibling(
+	t reflect.Type,
+	namespace,
+	name string,
+) string {
+	parent := parentNamespace(namespace)
+	if _, path, found := resolveNamespace(t, joinNamespace(parent, name)); found {
+		return path
+	}
+	if _, path, found := resolveNamespace(t, parent); found && path != "" {
+		return path + "." + name
+	}
+	return name
+}
+
+// resolveTop will compose the JSON path of the field referenced by the
+// given namespace relative to the validated top structure.
+func resolveTop(
+	t reflect.Type,
+	namespace string,
+) string {
+	root := t
+	for root.Kind() == reflect.Pointer {
+		root = root.Elem()
+	}
+	if _, path, found := resolveNamespace(t, joinNamespace(root.Name(), namespace)); found {
+		return path
+	}
+	return namespace
+}
+
+// parentNamespace will strip the last field step of the namespace.
+func parentNamespace(
+	namespace string,
+) string {
+	depth := 0
+	for i := len(namespace) - 1; i >= 0; i-- {
+		switch namespace[i] {
+		case ']':
+			depth++
+		case '[':
+			depth--
+		case '.':
+			if depth == 0 {
+				return namespace[:i]
+			}
+		}
+	}
+	return ""
+}
+
+func joinNamespace(
+	parent,
+	name string,
+) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// jsonName will retrieve the name of the field when encoded in JSON,
+// returning an empty string for embedded structures that are flattened
+// into the parent object.
+func jsonName(
+	field reflect.StructField,
+) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	switch {
+	case name == "-":
+		return field.Name
+	case name != "":
+		return name
+	case field.Anonymous:
+		t := field.Type
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Struct {
+			return ""
+		}
+	}
+	return field.Name
+}
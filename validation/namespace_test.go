@@ -0,0 +1,276 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+type namespaceTestEmbedded struct {
+	Code int `json:"code" vparam:"1"`
+}
+
+type namespaceTestItem struct {
+	Name  string `json:"name" vparam:"2"`
+	Score int    `vparam:"3"`
+}
+
+type namespaceTestRequest struct {
+	namespaceTestEmbedded
+	Tagged  namespaceTestEmbedded         `json:"tagged"`
+	Pointer *namespaceTestItem            `json:"pointer"`
+	Items   []namespaceTestItem           `json:"items,omitempty"`
+	Refs    []*namespaceTestItem          `json:"refs"`
+	Map     map[string]*namespaceTestItem `json:"map"`
+	Matrix  [][]namespaceTestItem         `json:"matrix"`
+	Values  []int                         `json:"values" vparam:"4"`
+	Ignored string                        `json:"-" vparam:"5"`
+}
+
+func Test_parseNamespace(t *testing.T) {
+	scenarios := []struct {
+		namespace string
+		expected  []namespaceStep
+	}{
+		{ // empty namespace
+			namespace: "",
+			expected:  nil,
+		},
+		{ // single field
+			namespace: "Field",
+			expected:  []namespaceStep{{name: "Field"}},
+		},
+		{ // nested fields
+			namespace: "Root.Field.Sub",
+			expected:  []namespaceStep{{name: "Root"}, {name: "Field"}, {name: "Sub"}},
+		},
+		{ // indexed fields
+			namespace: "Root.Items[2].Map[key].Field",
+			expected: []namespaceStep{
+				{name: "Root"},
+				{name: "Items", indexes: []string{"2"}},
+				{name: "Map", indexes: []string{"key"}},
+				{name: "Field"},
+			},
+		},
+		{ // multiple indexes and dotted map keys
+			namespace: "Matrix[1][2].Map[a.b]",
+			expected: []namespaceStep{
+				{name: "Matrix", indexes: []string{"1", "2"}},
+				{name: "Map", indexes: []string{"a.b"}},
+			},
+		},
+		{ // unterminated index
+			namespace: "Items[2",
+			expected:  []namespaceStep{{name: "Items", indexes: []string{"2"}}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.namespace, func(t *testing.T) {
+			if check := parseNamespace(s.namespace); !reflect.DeepEqual(check, s.expected) {
+				t.Errorf("returned the (%v) steps when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_resolveNamespace(t *testing.T) {
+	scenarios := []struct {
+		namespace string
+		value     interface{}
+		found     bool
+		field     string
+		path      string
+	}{
+		{ // empty namespace
+			namespace: "",
+			value:     namespaceTestRequest{},
+			found:     false,
+		},
+		{ // unknown field
+			namespace: "namespaceTestRequest.Unknown",
+			value:     namespaceTestRequest{},
+			found:     false,
+		},
+		{ // traversing a non structure field
+			namespace: "namespaceTestRequest.Values.Field",
+			value:     namespaceTestRequest{},
+			found:     false,
+		},
+		{ // promoted embedded field
+			namespace: "namespaceTestRequest.namespaceTestEmbedded.Code",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Code",
+			path:      "code",
+		},
+		{ // tagged structure field
+			namespace: "namespaceTestRequest.Tagged.Code",
+			value:     &namespaceTestRequest{},
+			found:     true,
+			field:     "Code",
+			path:      "tagged.code",
+		},
+		{ // pointer field
+			namespace: "namespaceTestRequest.Pointer.Name",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Name",
+			path:      "pointer.name",
+		},
+		{ // slice element
+			namespace: "namespaceTestRequest.Items[3].Score",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Score",
+			path:      "items[3].Score",
+		},
+		{ // slice pointer element
+			namespace: "namespaceTestRequest.Refs[0].Name",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Name",
+			path:      "refs[0].name",
+		},
+		{ // map element
+			namespace: "namespaceTestRequest.Map[key].Name",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Name",
+			path:      "map[key].name",
+		},
+		{ // multidimensional slice element
+			namespace: "namespaceTestRequest.Matrix[1][2].Name",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Name",
+			path:      "matrix[1][2].name",
+		},
+		{ // primitive slice element
+			namespace: "namespaceTestRequest.Values[1]",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Values",
+			path:      "values[1]",
+		},
+		{ // json ignored field
+			namespace: "namespaceTestRequest.Ignored",
+			value:     namespaceTestRequest{},
+			found:     true,
+			field:     "Ignored",
+			path:      "Ignored",
+		},
+		{ // anonymous root structure
+			namespace: "Field.Name",
+			value: struct {
+				Field namespaceTestItem `json:"field"`
+			}{},
+			found: true,
+			field: "Name",
+			path:  "field.name",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.namespace, func(t *testing.T) {
+			field, path, found := resolveNamespace(reflect.TypeOf(s.value), s.namespace)
+			switch {
+			case found != s.found:
+				t.Errorf("returned the (%v) found flag", found)
+			case field.Name != s.field:
+				t.Errorf("returned the (%v) field when expecting (%v)", field.Name, s.field)
+			case path != s.path:
+				t.Errorf("returned the (%v) path when expecting (%v)", path, s.path)
+			}
+		})
+	}
+}
+
+func Test_parentNamespace(t *testing.T) {
+	scenarios := []struct {
+		namespace string
+		expected  string
+	}{
+		{namespace: "", expected: ""},
+		{namespace: "Field", expected: ""},
+		{namespace: "Root.Field", expected: "Root"},
+		{namespace: "Root.Items[1].Field", expected: "Root.Items[1]"},
+		{namespace: "Root.Map[a.b].Field", expected: "Root.Map[a.b]"},
+		{namespace: "Root.Map[a.b]", expected: "Root"},
+		{namespace: "Root.Period.", expected: "Root.Period"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.namespace, func(t *testing.T) {
+			if check := parentNamespace(s.namespace); check != s.expected {
+				t.Errorf("returned the (%v) namespace when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_resolveSibling(t *testing.T) {
+	scenarios := []struct {
+		test      string
+		namespace string
+		name      string
+		expected  string
+	}{
+		{ // root sibling field
+			test:      "root sibling field",
+			namespace: "namespaceTestRequest.Values",
+			name:      "Items",
+			expected:  "items",
+		},
+		{ // nested sibling field
+			test:      "nested sibling field",
+			namespace: "namespaceTestRequest.Refs[1].Name",
+			name:      "Score",
+			expected:  "refs[1].Score",
+		},
+		{ // unknown nested sibling field
+			test:      "unknown nested sibling field",
+			namespace: "namespaceTestRequest.Refs[1].Name",
+			name:      "Unknown",
+			expected:  "refs[1].Unknown",
+		},
+		{ // unknown root sibling field
+			test:      "unknown root sibling field",
+			namespace: "namespaceTestRequest.Values",
+			name:      "Unknown",
+			expected:  "Unknown",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			if check := resolveSibling(reflect.TypeOf(&namespaceTestRequest{}), s.namespace, s.name); check != s.expected {
+				t.Errorf("returned the (%v) path when expecting (%v)", check, s.expected)
+			}
+		})
+	}
+}
+
+func Test_resolveTop(t *testing.T) {
+	t.Run("named root structure", func(t *testing.T) {
+		if check := resolveTop(reflect.TypeOf(&namespaceTestRequest{}), "Pointer.Name"); check != "pointer.name" {
+			t.Errorf("returned the (%v) path", check)
+		}
+	})
+
+	t.Run("anonymous root structure", func(t *testing.T) {
+		value := struct {
+			Field namespaceTestItem `json:"field"`
+		}{}
+
+		if check := resolveTop(reflect.TypeOf(value), "Field.Name"); check != "field.name" {
+			t.Errorf("returned the (%v) path", check)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		if check := resolveTop(reflect.TypeOf(namespaceTestRequest{}), "Unknown.Name"); check != "Unknown.Name" {
+			t.Errorf("returned the (%v) path", check)
+		}
+	})
+}
@@ -0,0 +1,337 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+const (
+	// ParamQuery defines the location of a request query parameter.
+	ParamQuery = "query"
+
+	// ParamPath defines the location of a request path parameter.
+	ParamPath = "path"
+
+	// ParamString defines the type of string parameters.
+	ParamString = "string"
+
+	// ParamInt defines the type of signed integer parameters.
+	ParamInt = "int"
+
+	// ParamUint defines the type of unsigned integer parameters.
+	ParamUint = "uint"
+
+	// ParamFloat defines the type of floating point parameters.
+	ParamFloat = "float"
+
+	// ParamBool defines the type of boolean parameters.
+	ParamBool = "bool"
+)
+
+// Param defines the schema of a request query or path parameter.
+// The parameter is read from the query string by default. Numeric
+// parameters are checked against the min and max range, while the range
+// of string parameters is checked against the value length. The enum
+// options are converted into the parameter type, so the values are
+// compared by their coerced value and not by their raw text. Repeated
+// parameters accept multiple or comma separated values, where each
+// value is checked against the schema. The code is the parameter code
+// assigned to the parameter envelope errors.
+type Param struct {
+	Name     string
+	In       string
+	Type     string
+	Required bool
+	Repeated bool
+	Default  interface{}
+	Min      *float64
+	Max      *float64
+	Enum     []string
+	Code     int
+}
+
+// Bound is a helper function used to define a parameter range limit.
+func Bound(
+	limit float64,
+) *float64 {
+	return &limit
+}
+
+// ParamSchema defines the list of parameters to be validated.
+type ParamSchema []Param
+
+// ParamError defines the information of a request parameter
+// validation error.
+type ParamError struct {
+	Name  string
+	Tag   string
+	Limit string
+	Code  int
+}
+
+// Params defines the coerced request parameters values, where repeated
+// parameters store the list of coerced values.
+type Params map[string]interface{}
+
+// String retrieves the string value of a parameter.
+func (p Params) String(
+	name string,
+) string {
+	v, _ := p[name].(string)
+	return v
+}
+
+// Int retrieves the signed integer value of a parameter.
+func (p Params) Int(
+	name string,
+) int {
+	v, _ := p[name].(int)
+	return v
+}
+
+// Uint retrieves the unsigned integer value of a parameter.
+func (p Params) Uint(
+	name string,
+) uint {
+	v, _ := p[name].(uint)
+	return v
+}
+
+// Float retrieves the floating point value of a parameter.
+func (p Params) Float(
+	name string,
+) float64 {
+	v, _ := p[name].(float64)
+	return v
+}
+
+// Bool retrieves the boolean value of a parameter.
+func (p Params) Bool(
+	name string,
+) bool {
+	v, _ := p[name].(bool)
+	return v
+}
+
+// List retrieves the list of values of a repeated parameter.
+func (p Params) List(
+	name string,
+) []interface{} {
+	v, _ := p[name].([]interface{})
+	return v
+}
+
+// ListReport will create a response list report from the pagination
+// parameters and the given total number of records.
+func (p Params) ListReport(
+	total uint,
+) *envelope.ListReport {
+	return envelope.NewListReport(
+		p.String(envelope.SearchQueryParam),
+		p.Uint(envelope.StartQueryParam),
+		p.Uint(envelope.CountQueryParam),
+		total,
+	)
+}
+
+// PaginationSchema returns the offset pagination parameters schema,
+// defining the search term, the page starting record and the page size
+// query parameters used to create a response list report.
+func PaginationSchema() ParamSchema {
+	return ParamSchema{
+		{Name: envelope.SearchQueryParam, Type: ParamString, Default: "", Code: 1},
+		{Name: envelope.StartQueryParam, Type: ParamUint, Default: uint(0), Code: 2},
+		{
+			Name:    envelope.CountQueryParam,
+			Type:    ParamUint,
+			Default: uint(PaginationCount),
+			Min:     Bound(1),
+			Max:     Bound(float64(PaginationMaxCount)),
+			Code:    3,
+		},
+	}
+}
+
+// ParamValidator is a function type used to define a calling interface
+// of function responsible to validate and coerce the request query and
+// path parameters, returning an initialized response envelope with all
+// the founded parameter errors
+type ParamValidator func(ctx *gin.Context, schema ParamSchema) (Params, *envelope.Envelope, error)
+
+// NewParamValidator instantiates a new request parameters validation
+// function.
+func NewParamValidator(
+	parser IParser,
+) (ParamValidator, error) {
+	// check parser argument reference
+	if parser == nil {
+		return nil, errNilPointer("parser")
+	}
+	// return the parameters validation method instance
+	return func(ctx *gin.Context, schema ParamSchema) (Params, *envelope.Envelope, error) {
+		// check the context argument reference
+		if ctx == nil || ctx.Request == nil {
+			return nil, nil, errNilPointer("ctx")
+		}
+		// validate and coerce all the schema parameters
+		params := Params{}
+		var errs []ParamError
+		for _, param := range schema {
+			value, perrs, e := validateParam(ctx, param)
+			switch {
+			case e != nil:
+				return nil, nil, e
+			case len(perrs) != 0:
+				errs = append(errs, perrs...)
+			case value != nil:
+				params[param.Name] = value
+			}
+		}
+		if len(errs) != 0 {
+			env, e := parser.ParseParams(errs, AcceptLanguages(ctx)...)
+			return nil, env, e
+		}
+		return params, nil, nil
+	}, nil
+}
+
+func validateParam(
+	ctx *gin.Context,
+	param Param,
+) (interface{}, []ParamError, error) {
+	// check the parameter schema
+	if param.Name == "" {
+		return nil, nil, errInvalidParam(param.Name)
+	}
+	switch param.Type {
+	case "":
+		param.Type = ParamString
+	case ParamString, ParamInt, ParamUint, ParamFloat, ParamBool:
+	default:
+		return nil, nil, errInvalidParam(param.Name, map[string]interface{}{"type": param.Type})
+	}
+	// retrieve the raw parameter values
+	var raw []string
+	tag := BindQuery
+	switch param.In {
+	case "", ParamQuery:
+		for _, v := range ctx.QueryArray(param.Name) {
+			if param.Repeated {
+				raw = append(raw, strings.Split(v, ",")...)
+			} else {
+				raw = append(raw, v)
+			}
+		}
+	case ParamPath:
+		tag = BindURI
+		if v, ok := ctx.Params.Get(param.Name); ok {
+			raw = append(raw, v)
+		}
+	default:
+		return nil, nil, errInvalidParam(param.Name, map[string]interface{}{"in": param.In})
+	}
+	// convert the enumeration options into the parameter type
+	var enum []interface{}
+	for _, option := range param.Enum {
+		value, _, e := convertParam(param.Type, option)
+		if e != nil {
+			return nil, nil, errInvalidParam(param.Name, map[string]interface{}{"enum": option})
+		}
+		enum = append(enum, value)
+	}
+	// check the parameter presence
+	if len(raw) == 0 || (len(raw) == 1 && raw[0] == "") {
+		if param.Required {
+			return nil, []ParamError{{Name: param.Name, Tag: "required", Code: param.Code}}, nil
+		}
+		return param.Default, nil, nil
+	}
+	// coerce and check each of the parameter values
+	var values []interface{}
+	var errs []ParamError
+	for _, r := range raw {
+		value, e := coerceParam(param, enum, r, tag)
+		if e != nil {
+			errs = append(errs, *e)
+			continue
+		}
+		values = append(values, value)
+	}
+	switch {
+	case len(errs) != 0:
+		return nil, errs, nil
+	case param.Repeated:
+		return values, nil, nil
+	}
+	return values[0], nil, nil
+}
+
+func coerceParam(
+	param Param,
+	enum []interface{},
+	raw string,
+	tag string,
+) (interface{}, *ParamError) {
+	// convert the raw value into the parameter type
+	value, size, e := convertParam(param.Type, raw)
+	if e != nil {
+		return nil, &ParamError{Name: param.Name, Tag: tag, Code: param.Code}
+	}
+	// check the value range
+	if param.Type != ParamBool {
+		if param.Min != nil && size < *param.Min {
+			return nil, &ParamError{Name: param.Name, Tag: "min", Limit: formatLimit(*param.Min), Code: param.Code}
+		}
+		if param.Max != nil && size > *param.Max {
+			return nil, &ParamError{Name: param.Name, Tag: "max", Limit: formatLimit(*param.Max), Code: param.Code}
+		}
+	}
+	// check the value against the enumeration list
+	if len(enum) != 0 {
+		found := false
+		for _, option := range enum {
+			found = found || option == value
+		}
+		if !found {
+			return nil, &ParamError{Name: param.Name, Tag: "oneof", Limit: strings.Join(param.Enum, " "), Code: param.Code}
+		}
+	}
+	return value, nil
+}
+
+func convertParam(
+	t string,
+	raw string,
+) (interface{}, float64, error) {
+	switch t {
+	case ParamInt:
+		v, e := strconv.Atoi(raw)
+		return v, float64(v), e
+	case ParamUint:
+		v, e := strconv.ParseUint(raw, 10, 0)
+		return uint(v), float64(v), e
+	case ParamFloat:
+		v, e := strconv.ParseFloat(raw, 64)
+		// the not a number and infinite values aren't valid parameters
+		if e == nil && (math.IsNaN(v) || math.IsInf(v, 0)) {
+			e = strconv.ErrSyntax
+		}
+		return v, v, e
+	case ParamBool:
+		v, e := strconv.ParseBool(raw)
+		return v, 0, e
+	default:
+		return raw, float64(len([]rune(raw))), nil
+	}
+}
+
+func formatLimit(
+	limit float64,
+) string {
+	return fmt.Sprintf("%v", limit)
+}
@@ -0,0 +1,326 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	translations "github.com/go-playground/validator/v10/translations/en"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func newTestParamValidator() ParamValidator {
+	lang := en.New()
+	translator, _ := ut.New(lang, lang).GetTranslator("en")
+	_ = translations.RegisterDefaultTranslations(validator.New(), translator)
+	parser, _ := NewParser(staticLocalizer(translator))
+	v, _ := NewParamValidator(parser)
+	return v
+}
+
+func Test_Params(t *testing.T) {
+	params := Params{
+		"string": "value",
+		"int":    -1,
+		"uint":   uint(2),
+		"float":  1.5,
+		"bool":   true,
+		"list":   []interface{}{1, 2},
+	}
+
+	t.Run("retrieve the typed values", func(t *testing.T) {
+		switch {
+		case params.String("string") != "value":
+			t.Errorf("returned the (%v) string", params.String("string"))
+		case params.Int("int") != -1:
+			t.Errorf("returned the (%v) int", params.Int("int"))
+		case params.Uint("uint") != 2:
+			t.Errorf("returned the (%v) uint", params.Uint("uint"))
+		case params.Float("float") != 1.5:
+			t.Errorf("returned the (%v) float", params.Float("float"))
+		case !params.Bool("bool"):
+			t.Errorf("returned the (%v) bool", params.Bool("bool"))
+		case !reflect.DeepEqual(params.List("list"), []interface{}{1, 2}):
+			t.Errorf("returned the (%v) list", params.List("list"))
+		}
+	})
+
+	t.Run("retrieve the zero value of missing or mismatched values", func(t *testing.T) {
+		switch {
+		case params.String("int") != "":
+			t.Errorf("returned the (%v) string", params.String("int"))
+		case params.Int("missing") != 0:
+			t.Errorf("returned the (%v) int", params.Int("missing"))
+		case params.Uint("int") != 0:
+			t.Errorf("returned the (%v) uint", params.Uint("int"))
+		case params.Float("missing") != 0:
+			t.Errorf("returned the (%v) float", params.Float("missing"))
+		case params.Bool("string"):
+			t.Errorf("returned the (%v) bool", params.Bool("string"))
+		case params.List("string") != nil:
+			t.Errorf("returned the (%v) list", params.List("string"))
+		}
+	})
+
+	t.Run("create the list report", func(t *testing.T) {
+		params := Params{envelope.SearchQueryParam: "term", envelope.StartQueryParam: uint(2), envelope.CountQueryParam: uint(2)}
+		expected := envelope.NewListReport("term", 2, 2, 10)
+
+		if check := params.ListReport(10); !reflect.DeepEqual(check, expected) {
+			t.Errorf("returned the (%v) report when expecting (%v)", check, expected)
+		}
+	})
+}
+
+func Test_PaginationSchema(t *testing.T) {
+	t.Run("coerce the default pagination parameters", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+		params, env, e := newTestParamValidator()(ctx, PaginationSchema())
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		case !reflect.DeepEqual(params.ListReport(50), envelope.NewListReport("", 0, uint(PaginationCount), 50)):
+			t.Errorf("returned the (%v) report", params.ListReport(50))
+		}
+	})
+
+	t.Run("coerce the requested pagination parameters", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, "/?search=term&start=10&count=5", "", "", nil)
+
+		params, env, e := newTestParamValidator()(ctx, PaginationSchema())
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		case !reflect.DeepEqual(params.ListReport(50), envelope.NewListReport("term", 10, 5, 50)):
+			t.Errorf("returned the (%v) report", params.ListReport(50))
+		}
+	})
+
+	t.Run("reject the out of range page size", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodGet, fmt.Sprintf("/?start=-1&count=%d", PaginationMaxCount+1), "", "", nil)
+
+		_, env, e := newTestParamValidator()(ctx, PaginationSchema())
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env == nil || len(env.Status.Errors) != 2:
+			t.Errorf("didn't returned the expected envelope")
+		case env.Status.Errors[0].GetCode() != "p:2.c:117" || env.Status.Errors[0].Field != envelope.StartQueryParam:
+			t.Errorf("returned the (%v) start error", env.Status.Errors[0])
+		case env.Status.Errors[1].GetCode() != "p:3.c:101" || env.Status.Errors[1].GetMessage() != fmt.Sprintf("count must be at most %d", PaginationMaxCount):
+			t.Errorf("returned the (%v) count error", env.Status.Errors[1])
+		}
+	})
+}
+
+func Test_NewParamValidator(t *testing.T) {
+	t.Run("nil parser", func(t *testing.T) {
+		if _, e := NewParamValidator(nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("new parameters validator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if sut, e := NewParamValidator(NewMockParser(ctrl)); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if sut == nil {
+			t.Error("didn't returned a valid reference")
+		}
+	})
+}
+
+func Test_ParamValidator_Call(t *testing.T) {
+	t.Run("nil context", func(t *testing.T) {
+		if _, _, e := newTestParamValidator()(nil, ParamSchema{}); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("invalid parameter schema", func(t *testing.T) {
+		scenarios := []struct {
+			test  string
+			param Param
+		}{
+			{ // missing name
+				test:  "missing name",
+				param: Param{},
+			},
+			{ // unknown type
+				test:  "unknown type",
+				param: Param{Name: "name", Type: "date"},
+			},
+			{ // unknown location
+				test:  "unknown location",
+				param: Param{Name: "name", In: "header"},
+			},
+			{ // invalid enumeration option
+				test:  "invalid enumeration option",
+				param: Param{Name: "name", Type: ParamInt, Enum: []string{"1", "x"}},
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+
+				if _, _, e := newTestParamValidator()(ctx, ParamSchema{s.param}); !errors.Is(e, ErrInvalidParam) {
+					t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidParam)
+				}
+			})
+		}
+	})
+
+	t.Run("coerce the parameter values", func(t *testing.T) {
+		ctx := newTestBinderContext(
+			http.MethodGet,
+			"/users/7?name=john&score=-1.5&active=true&tags=a,b&tags=c&ids=1&ids=2&level=-3&ratio=1",
+			"",
+			"",
+			gin.Params{{Key: "id", Value: "7"}},
+		)
+		schema := ParamSchema{
+			{Name: "id", In: ParamPath, Type: ParamUint, Required: true},
+			{Name: "name", Min: Bound(2), Max: Bound(10)},
+			{Name: "score", Type: ParamFloat, Min: Bound(-2)},
+			{Name: "active", Type: ParamBool},
+			{Name: "tags", Repeated: true, Enum: []string{"a", "b", "c"}},
+			{Name: "ids", Type: ParamInt, Repeated: true},
+			{Name: "level", Type: ParamInt},
+			{Name: "ratio", Type: ParamFloat, Enum: []string{"1.0", "2.5"}},
+			{Name: "order", Default: "asc"},
+			{Name: "missing"},
+		}
+		expected := Params{
+			"id":     uint(7),
+			"name":   "john",
+			"score":  -1.5,
+			"active": true,
+			"tags":   []interface{}{"a", "b", "c"},
+			"ids":    []interface{}{1, 2},
+			"level":  -3,
+			"ratio":  1.0,
+			"order":  "asc",
+		}
+
+		params, env, e := newTestParamValidator()(ctx, schema)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		case !reflect.DeepEqual(params, expected):
+			t.Errorf("returned the (%v) params when expecting (%v)", params, expected)
+		}
+	})
+
+	t.Run("aggregate all the parameter errors", func(t *testing.T) {
+		ctx := newTestBinderContext(
+			http.MethodGet,
+			"/users/x?name=j&score=3&active=maybe&tags=a,d",
+			"",
+			"",
+			gin.Params{{Key: "id", Value: "x"}},
+		)
+		schema := ParamSchema{
+			{Name: "id", In: ParamPath, Type: ParamUint, Code: 1},
+			{Name: "name", Min: Bound(2), Code: 2},
+			{Name: "score", Type: ParamFloat, Max: Bound(2.5), Code: 3},
+			{Name: "active", Type: ParamBool, Code: 4},
+			{Name: "tags", Repeated: true, Enum: []string{"a", "b"}, Code: 5},
+			{Name: "order", Required: true, Code: 6},
+		}
+		expected := []struct {
+			code    string
+			field   string
+			message string
+		}{
+			{code: "p:1.c:116", field: "id", message: "invalid path parameter"},
+			{code: "p:2.c:102", field: "name", message: "name must be at least 2"},
+			{code: "p:3.c:101", field: "score", message: "score must be at most 2.5"},
+			{code: "p:4.c:117", field: "active", message: "invalid query parameter"},
+			{code: "p:5.c:103", field: "tags", message: "tags must be one of [a b]"},
+			{code: "p:6.c:104", field: "order", message: "order is a required field"},
+		}
+
+		params, env, e := newTestParamValidator()(ctx, schema)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected (%v) error", e)
+		case params != nil:
+			t.Errorf("returned the unexpected (%v) params", params)
+		case env == nil || len(env.Status.Errors) != len(expected):
+			t.Errorf("didn't returned the expected envelope")
+		case env.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", env.GetStatusCode())
+		default:
+			for i, x := range expected {
+				check := env.Status.Errors[i]
+				switch {
+				case check.GetCode() != x.code:
+					t.Errorf("returned the (%v) code when expecting (%v)", check.GetCode(), x.code)
+				case check.Field != x.field:
+					t.Errorf("returned the (%v) field when expecting (%v)", check.Field, x.field)
+				case check.GetMessage() != x.message:
+					t.Errorf("returned the (%v) message when expecting (%v)", check.GetMessage(), x.message)
+				}
+			}
+		}
+	})
+
+	t.Run("reject the non finite float values", func(t *testing.T) {
+		for _, raw := range []string{"NaN", "Inf", "-Inf", "infinity"} {
+			t.Run(raw, func(t *testing.T) {
+				ctx := newTestBinderContext(http.MethodGet, "/?score="+raw, "", "", nil)
+				schema := ParamSchema{{Name: "score", Type: ParamFloat, Code: 1}}
+
+				params, env, e := newTestParamValidator()(ctx, schema)
+				switch {
+				case e != nil:
+					t.Errorf("returned the unexpected (%v) error", e)
+				case params != nil:
+					t.Errorf("returned the unexpected (%v) params", params)
+				case env == nil || len(env.Status.Errors) != 1:
+					t.Errorf("didn't returned the expected envelope")
+				case env.Status.Errors[0].GetCode() != "p:1.c:117":
+					t.Errorf("returned the (%v) code", env.Status.Errors[0].GetCode())
+				}
+			})
+		}
+	})
+
+	t.Run("parse the errors with the request locale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+		ctx.Request.Header.Set("Accept-Language", "pt-PT")
+		expected := envelope.NewEnvelope(http.StatusBadRequest, nil)
+		parser := NewMockParser(ctrl)
+		parser.EXPECT().ParseParams([]ParamError{{Name: "name", Tag: "required"}}, "pt-PT").Return(expected, nil).Times(1)
+		sut, _ := NewParamValidator(parser)
+
+		if _, env, e := sut(ctx, ParamSchema{{Name: "name", Required: true}}); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if env != expected {
+			t.Errorf("returned the (%v) envelope", env)
+		}
+	})
+}
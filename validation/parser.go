@@ -1,35 +1,73 @@
 package validation
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/happyhippyhippo/slate-rest/envelope"
 )
 
+const (
+	// BindURI defines the error mapping name of the request path
+	// parameters binding errors.
+	BindURI = "bind_uri"
+
+	// BindQuery defines the error mapping name of the request query
+	// parameters binding errors.
+	BindQuery = "bind_query"
+
+	// BindHeader defines the error mapping name of the request
+	// headers binding errors.
+	BindHeader = "bind_header"
+
+	// BindBody defines the error mapping name of the request
+	// body binding errors.
+	BindBody = "bind_body"
+
+	// BindMediaType defines the error mapping name of the request
+	// body unsupported content type errors.
+	BindMediaType = "bind_media_type"
+)
+
 // IParser defines the interface to an error parsing object used to
 // convert a validation error into an envelope error
 type IParser interface {
-	Parse(val interface{}, errs validator.ValidationErrors) (*envelope.Envelope, error)
+	Parse(val interface{}, errs validator.ValidationErrors, locale ...string) (*envelope.Envelope, error)
+	ParseBinding(source string, e error, locale ...string) (*envelope.Envelope, error)
+	ParseParams(errs []ParamError, locale ...string) (*envelope.Envelope, error)
+	ParseSchema(errs []SchemaError, locale ...string) (*envelope.Envelope, error)
 	AddError(e string, code int)
+	SetCodes(codes map[string]int)
+	SetDefaultCode(code int)
+	Codes() map[string]int
+	DefaultCode() int
 }
 
 type parser struct {
-	mapper     map[string]int
-	translator ut.Translator
+	mutex       sync.RWMutex
+	mapper      map[string]int
+	codes       map[string]int
+	defaultCode int
+	messages    map[string]string
+	localizer   Localizer
 }
 
 var _ IParser = &parser{}
 
 // NewParser instantiate a new validation parser instance
 func NewParser(
-	translator ut.Translator,
+	localizer Localizer,
 ) (IParser, error) {
-	if translator == nil {
-		return nil, errNilPointer("translator")
+	if localizer == nil {
+		return nil, errNilPointer("localizer")
 	}
 
 	return &parser{
@@ -154,16 +192,63 @@ func NewParser(
 			"excluded_without":     113,
 			"excluded_without_all": 114,
 			"unique":               115,
+
+			BindURI:    116,
+			BindQuery:  117,
+			BindHeader: 118,
+			BindBody:   119,
+
+			BindMediaType: 127,
+
+			"type":                  120,
+			"pattern":               121,
+			"multiple_of":           122,
+			"additional_properties": 123,
+			"any_of":                124,
+			"one_of":                125,
+			"not":                   126,
+		},
+		messages: map[string]string{
+			BindURI:    "invalid path parameter",
+			BindQuery:  "invalid query parameter",
+			BindHeader: "invalid header",
+			BindBody:   "invalid request body",
+
+			BindMediaType: "unsupported media type",
+
+			"required": "{0} is required",
+			"min":      "{0} must be at least {1}",
+			"max":      "{0} must be at most {1}",
+			"oneof":    "{0} must be one of [{1}]",
+			"eq":       "{0} must be equal to {1}",
+			"gt":       "{0} must be greater than {1}",
+			"gte":      "{0} must be greater than or equal to {1}",
+			"lt":       "{0} must be less than {1}",
+			"lte":      "{0} must be less than or equal to {1}",
+			"unique":   "{0} must contain unique values",
+
+			"type":                  "{0} must be of type {1}",
+			"pattern":               "{0} must match the pattern {1}",
+			"multiple_of":           "{0} must be a multiple of {1}",
+			"additional_properties": "{0} is not an allowed property",
+			"any_of":                "{0} must match at least one of the schemas",
+			"one_of":                "{0} must match exactly one of the schemas",
+			"not":                   "{0} must not match the schema",
 		},
-		translator: translator,
+		codes:       map[string]int{},
+		defaultCode: DefaultCode,
+		localizer:   localizer,
 	}, nil
 }
 
 // Parse method that will convert the list of validation error into
 // an envelope struct to be used as the endpoint response.
-func (p parser) Parse(
+// The error messages are translated to the first supported locale of the
+// given list, or to the default locale if none is supported.
+func (p *parser) Parse(
 	val interface{},
 	errs validator.ValidationErrors,
+	locale ...string,
 ) (*envelope.Envelope, error) {
 	if val == nil {
 		return nil, errNilPointer("value")
@@ -173,9 +258,10 @@ func (p parser) Parse(
 		return nil, nil
 	}
 
+	translator := p.localizer(locale...)
 	resp := envelope.NewEnvelope(http.StatusBadRequest, nil, nil)
 	for _, e := range errs {
-		parsed, result := p.convert(val, e)
+		parsed, result := p.convert(val, e, translator)
 		if result != nil {
 			return nil, result
 		}
@@ -185,31 +271,280 @@ func (p parser) Parse(
 	return resp, nil
 }
 
+// ParseBinding method that will convert a request binding error of the
+// given source into an envelope struct to be used as the endpoint response.
+// The error message is translated with the source mapping name as the
+// translation key, falling back to a default message if not translated.
+func (p *parser) ParseBinding(
+	source string,
+	e error,
+	locale ...string,
+) (*envelope.Envelope, error) {
+	if e == nil {
+		return nil, nil
+	}
+	// retrieve the translated error message
+	message, te := p.localizer(locale...).T(source)
+	if te != nil || message == "" {
+		message = p.messages[source]
+	}
+	parsed := envelope.NewStatusError(p.code(source), message)
+	// assign the failing field if it can be discovered from the error
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(e, &typeErr) && typeErr.Field != "" {
+		parsed.SetField(typeErr.Field)
+	}
+	// the unsupported body content type has its own response status
+	status := http.StatusBadRequest
+	if source == BindMediaType {
+		status = http.StatusUnsupportedMediaType
+	}
+	return envelope.NewEnvelope(status, nil).AddError(parsed), nil
+}
+
+// ParseParams method that will convert the list of request parameter
+// errors into an envelope struct to be used as the endpoint response.
+// The error messages are translated with the error tag as the
+// translation key, and the parameter name and limit as arguments.
+func (p *parser) ParseParams(
+	errs []ParamError,
+	locale ...string,
+) (*envelope.Envelope, error) {
+	if len(errs) == 0 {
+		return nil, nil
+	}
+
+	translator := p.localizer(locale...)
+	resp := envelope.NewEnvelope(http.StatusBadRequest, nil, nil)
+	for _, e := range errs {
+		message := p.message(translator, e.Tag, e.Name, e.Limit)
+		resp = resp.AddError(envelope.NewStatusError(p.code(e.Tag), message).SetParam(e.Code).SetField(e.Name))
+	}
+	return resp, nil
+}
+
+// ParseSchema method that will convert the list of JSON Schema
+// violations into an envelope struct to be used as the endpoint
+// response. The error messages are translated with the error tag as the
+// translation key, and the JSON pointer and limit as arguments.
+func (p *parser) ParseSchema(
+	errs []SchemaError,
+	locale ...string,
+) (*envelope.Envelope, error) {
+	if len(errs) == 0 {
+		return nil, nil
+	}
+
+	translator := p.localizer(locale...)
+	resp := envelope.NewEnvelope(http.StatusBadRequest, nil, nil)
+	for _, e := range errs {
+		// the document root violations are referenced as the body
+		name := e.Pointer
+		if name == "" {
+			name = "body"
+		}
+		parsed := envelope.NewStatusError(p.code(e.Tag), p.message(translator, e.Tag, name, e.Limit))
+		if e.Pointer != "" {
+			parsed.SetField(e.Pointer)
+		}
+		resp = resp.AddError(parsed)
+	}
+	return resp, nil
+}
+
 // AddError will add a validation mapped error to code value.
 func (p *parser) AddError(
 	e string,
 	code int,
 ) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 	p.mapper[e] = code
 }
 
-func (p parser) convert(
+// SetCodes will replace the list of mapped error codes that override
+// the parser built-in and added error codes.
+func (p *parser) SetCodes(
+	codes map[string]int,
+) {
+	overrides := map[string]int{}
+	for e, code := range codes {
+		overrides[e] = code
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.codes = overrides
+}
+
+// SetDefaultCode will assign the error code used for the validation
+// errors without a mapped code.
+func (p *parser) SetDefaultCode(
+	code int,
+) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.defaultCode = code
+}
+
+// Codes will retrieve the effective validation error to code mapping
+// table, composed by the built-in, added and overridden error codes.
+func (p *parser) Codes() map[string]int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	codes := map[string]int{}
+	for e, code := range p.mapper {
+		codes[e] = code
+	}
+	for e, code := range p.codes {
+		codes[e] = code
+	}
+	return codes
+}
+
+// DefaultCode will retrieve the error code used for the validation
+// errors without a mapped code.
+func (p *parser) DefaultCode() int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.defaultCode
+}
+
+func (p *parser) code(
+	e string,
+) int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if code, ok := p.codes[e]; ok {
+		return code
+	}
+	if code, ok := p.mapper[e]; ok {
+		return code
+	}
+	return p.defaultCode
+}
+
+func (p *parser) message(
+	translator ut.Translator,
+	tag string,
+	name string,
+	limit string,
+) string {
+	message, te := translator.T(tag, name, limit)
+	if te != nil || message == "" {
+		message = strings.NewReplacer("{0}", name, "{1}", limit).Replace(p.messages[tag])
+	}
+	return message
+}
+
+func (p *parser) convert(
 	value interface{},
 	e validator.FieldError,
+	translator ut.Translator,
 ) (*envelope.StatusError, error) {
 	if e == nil {
 		return nil, errNilPointer("error")
 	}
 
 	typeof := reflect.TypeOf(value)
-	field, _ := typeof.FieldByName(e.StructField())
+	namespace := e.StructNamespace()
+	// convert the struct-level reported errors
+	if se, ok := structError(e.Value()); ok {
+		return p.convertStructError(typeof, namespace, e, se, translator), nil
+	}
+
+	// the plain struct-level reported errors can reference a name that
+	// isn't a structure field, so it is resolved as a sibling field
+	field, path, found := resolveNamespace(typeof, namespace)
+	if !found {
+		path = resolveSibling(typeof, namespace, e.StructField())
+	}
 	iparam := 0
-	if param, ok := field.Tag.Lookup("vparam"); ok {
+	if param, ok := field.Tag.Lookup("vparam"); found && ok {
 		var err error
 		if iparam, err = strconv.Atoi(param); err != nil {
 			return nil, err
 		}
 	}
 
-	return envelope.NewStatusError(p.mapper[e.Tag()], e.Translate(p.translator)).SetParam(iparam), nil
+	tag := e.Tag()
+	parsed := envelope.NewStatusError(p.code(tag), p.translate(translator, e, path)).SetParam(iparam)
+	if path != "" {
+		parsed.SetField(path)
+	}
+	// reference the compared field of the cross-field errors
+	switch {
+	case crossFieldTags[tag]:
+		parsed.SetMeta("fields", []string{path, resolveSibling(typeof, namespace, e.Param())})
+	case crossStructTags[tag]:
+		parsed.SetMeta("fields", []string{path, resolveTop(typeof, e.Param())})
+	}
+	return parsed, nil
+}
+
+func (p *parser) convertStructError(
+	typeof reflect.Type,
+	namespace string,
+	e validator.FieldError,
+	se *StructError,
+	translator ut.Translator,
+) *envelope.StatusError {
+	// use the reported code, or the error tag mapped code
+	code := se.Code
+	if code == 0 {
+		code = p.code(e.Tag())
+	}
+	// resolve the JSON path of the reported fields
+	var fields []string
+	for _, name := range se.Fields {
+		fields = append(fields, resolveSibling(typeof, namespace, name))
+	}
+	path := ""
+	if len(fields) != 0 {
+		path = fields[0]
+	} else if _, parent, found := resolveNamespace(typeof, parentNamespace(namespace)); found {
+		path = parent
+	}
+	// use the reported message translation, or the error tag message
+	message := p.translate(translator, e, path)
+	if se.Message != "" {
+		message = se.Message
+		if translated, te := translator.T(se.Message, se.Fields...); te == nil && translated != "" {
+			message = translated
+		}
+	}
+	parsed := envelope.NewStatusError(code, message)
+	// assign the reported parameters
+	if len(se.Params) != 0 {
+		parsed.SetParam(se.Params[0])
+	}
+	if len(se.Params) > 1 {
+		parsed.SetMeta("params", se.Params)
+	}
+	if path != "" {
+		parsed.SetField(path)
+	}
+	if len(fields) > 1 {
+		parsed.SetMeta("fields", fields)
+	}
+	return parsed
+}
+
+// translate will retrieve the translated message of the validation
+// error, falling back to the parser error tag message if the tag has
+// no registered translation, or to a generic tag failure message.
+func (p *parser) translate(
+	translator ut.Translator,
+	e validator.FieldError,
+	name string,
+) string {
+	if message := e.Translate(translator); message != e.Error() {
+		return message
+	}
+	if name == "" {
+		name = e.Field()
+	}
+	if message := p.message(translator, e.Tag(), name, e.Param()); message != "" {
+		return message
+	}
+	return fmt.Sprintf("failed the %s validation", e.Tag())
 }
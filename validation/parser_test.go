@@ -1,17 +1,30 @@
 package validation
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang/mock/gomock"
 	"github.com/happyhippyhippo/slate"
 )
 
+func staticLocalizer(
+	translator ut.Translator,
+) Localizer {
+	return func(...string) ut.Translator {
+		return translator
+	}
+}
+
 func Test_NewParser(t *testing.T) {
-	t.Run("nil translator", func(t *testing.T) {
+	t.Run("nil localizer", func(t *testing.T) {
 		parser, e := NewParser(nil)
 		switch {
 		case parser != nil:
@@ -29,14 +42,14 @@ func Test_NewParser(t *testing.T) {
 
 		translator := NewMockTranslator(ctrl)
 
-		p, e := NewParser(translator)
+		p, e := NewParser(staticLocalizer(translator))
 		switch {
 		case p == nil:
 			t.Error("didn't returned a valid reference")
 		case e != nil:
 			t.Errorf("return the (%v) error", e)
-		case p.(*parser).translator != translator:
-			t.Error("didn't stored the translator reference")
+		case p.(*parser).localizer() != translator:
+			t.Error("didn't stored the localizer reference")
 		}
 	})
 }
@@ -47,7 +60,7 @@ func Test_Parser_Parse(t *testing.T) {
 		defer ctrl.Finish()
 
 		translator := NewMockTranslator(ctrl)
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(nil, []validator.FieldError{})
 		switch {
@@ -66,7 +79,7 @@ func Test_Parser_Parse(t *testing.T) {
 
 		value := struct{ Message string }{Message: "message"}
 		translator := NewMockTranslator(ctrl)
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(value, nil)
 		switch {
@@ -83,7 +96,7 @@ func Test_Parser_Parse(t *testing.T) {
 
 		value := struct{ Message string }{Message: "message"}
 		translator := NewMockTranslator(ctrl)
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(value, []validator.FieldError{})
 		switch {
@@ -103,7 +116,7 @@ func Test_Parser_Parse(t *testing.T) {
 		}{Field: "message"}
 		translator := NewMockTranslator(ctrl)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(data, []validator.FieldError{nil})
 		switch {
@@ -126,9 +139,10 @@ func Test_Parser_Parse(t *testing.T) {
 		expected := `strconv.Atoi: parsing "string": invalid syntax`
 		translator := NewMockTranslator(ctrl)
 		fieldError := NewMockFieldError(ctrl)
-		fieldError.EXPECT().StructField().Return("Field").Times(1)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
 		switch {
@@ -152,11 +166,13 @@ func Test_Parser_Parse(t *testing.T) {
 		expected := "c:89"
 		translator := NewMockTranslator(ctrl)
 		fieldError := NewMockFieldError(ctrl)
-		fieldError.EXPECT().StructField().Return("Field").Times(1)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
 		fieldError.EXPECT().Translate(translator).Return(errMsg).Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
 		fieldError.EXPECT().Tag().Return("gt").Times(1)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
 		switch {
@@ -179,14 +195,16 @@ func Test_Parser_Parse(t *testing.T) {
 			Field int `validate:"gt=0"`
 		}{Field: 0}
 		errMsg := "error message"
-		expected := "c:0"
+		expected := "c:999"
 		translator := NewMockTranslator(ctrl)
 		fieldError := NewMockFieldError(ctrl)
-		fieldError.EXPECT().StructField().Return("Field").Times(1)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
 		fieldError.EXPECT().Translate(translator).Return(errMsg).Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
 		fieldError.EXPECT().Tag().Return("unrecognized").Times(1)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
 		switch {
@@ -210,11 +228,13 @@ func Test_Parser_Parse(t *testing.T) {
 		errMsg := "error message"
 		translator := NewMockTranslator(ctrl)
 		fieldError := NewMockFieldError(ctrl)
-		fieldError.EXPECT().StructField().Return("Field").Times(1)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
 		fieldError.EXPECT().Translate(translator).Return(errMsg).Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
 		fieldError.EXPECT().Tag().Return("gt").Times(1)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 
 		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
 		switch {
@@ -226,6 +246,313 @@ func Test_Parser_Parse(t *testing.T) {
 			t.Errorf("returned the (%v) error message instead of the expected (%v)", resp.Status.Errors[0].GetMessage(), errMsg)
 		}
 	})
+
+	t.Run("generating error for a structure pointer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		data := &struct {
+			Field int `validate:"gt=0" vparam:"10"`
+		}{Field: 0}
+		expected := "p:10.c:89"
+		translator := NewMockTranslator(ctrl)
+		fieldError := NewMockFieldError(ctrl)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
+		fieldError.EXPECT().Translate(translator).Return("error message").Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
+		fieldError.EXPECT().Tag().Return("gt").Times(1)
+
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.Status.Errors[0].GetCode() != expected:
+			t.Errorf("returned the (%v) error code instead of the expected (%s)", resp.Status.Errors[0].GetCode(), expected)
+		}
+	})
+
+	t.Run("translate to the requested locale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		data := struct {
+			Field int `validate:"gt=0"`
+		}{Field: 0}
+		locale := []string{"pt", "en"}
+		translator := NewMockTranslator(ctrl)
+		fieldError := NewMockFieldError(ctrl)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
+		fieldError.EXPECT().Translate(translator).Return("mensagem de erro").Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
+		fieldError.EXPECT().Tag().Return("gt").Times(1)
+		var requested []string
+		sut, _ := NewParser(func(l ...string) ut.Translator {
+			requested = l
+			return translator
+		})
+
+		resp, e := sut.Parse(data, []validator.FieldError{fieldError}, locale...)
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case !reflect.DeepEqual(requested, locale):
+			t.Errorf("requested the (%v) locales when expecting (%v)", requested, locale)
+		case resp.Status.Errors[0].GetMessage() != "mensagem de erro":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		}
+	})
+
+	t.Run("generating errors for nested and indexed fields", func(t *testing.T) {
+		type item struct {
+			Name string `json:"name" validate:"required" vparam:"3"`
+		}
+		type request struct {
+			Owner *item            `json:"owner" validate:"required"`
+			Items []item           `json:"items" validate:"dive"`
+			Tags  map[string]*item `json:"tags" validate:"dive"`
+			Codes []int            `json:"codes" validate:"dive,gt=0" vparam:"4"`
+		}
+		data := &request{
+			Owner: &item{},
+			Items: []item{{Name: "name"}, {}},
+			Tags:  map[string]*item{"key": {}},
+			Codes: []int{1, 0},
+		}
+		expected := []struct {
+			code  string
+			field string
+		}{
+			{code: "p:3.c:104", field: "owner.name"},
+			{code: "p:3.c:104", field: "items[1].name"},
+			{code: "p:3.c:104", field: "tags[key].name"},
+			{code: "p:4.c:89", field: "codes[1]"},
+		}
+		translator, _ := ut.New(en.New(), en.New()).GetTranslator("en")
+		validate := validator.New()
+
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.Parse(data, validate.Struct(data).(validator.ValidationErrors))
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case len(resp.Status.Errors) != len(expected):
+			t.Errorf("returned the (%v) errors", resp.Status.Errors)
+		default:
+			for i, x := range expected {
+				if check := resp.Status.Errors[i]; check.GetCode() != x.code || check.Field != x.field {
+					t.Errorf("returned the (%v, %v) error when expecting (%v, %v)", check.GetCode(), check.Field, x.code, x.field)
+				}
+			}
+		}
+	})
+}
+
+func Test_Parser_ParseBinding(t *testing.T) {
+	t.Run("no-op on nil error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut, _ := NewParser(staticLocalizer(NewMockTranslator(ctrl)))
+
+		if resp, e := sut.ParseBinding(BindBody, nil); resp != nil {
+			t.Error("returned an unexpectedly valid instance of a response")
+		} else if e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		}
+	})
+
+	t.Run("generating error with the default message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T(BindQuery).Return("", fmt.Errorf("error message")).Times(1)
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.ParseBinding(BindQuery, fmt.Errorf("error message"))
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", resp.GetStatusCode())
+		case resp.Status.Errors[0].GetCode() != "c:117":
+			t.Errorf("returned the (%v) error code", resp.Status.Errors[0].GetCode())
+		case resp.Status.Errors[0].GetMessage() != "invalid query parameter":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		}
+	})
+
+	t.Run("generating error with the translated message and field", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T(BindBody).Return("translated message", nil).Times(1)
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.ParseBinding(BindBody, fmt.Errorf("wrapped : %w", &json.UnmarshalTypeError{Field: "field"}))
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.Status.Errors[0].GetCode() != "c:119":
+			t.Errorf("returned the (%v) error code", resp.Status.Errors[0].GetCode())
+		case resp.Status.Errors[0].GetMessage() != "translated message":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		case resp.Status.Errors[0].Field != "field":
+			t.Errorf("returned the (%v) error field", resp.Status.Errors[0].Field)
+		}
+	})
+
+	t.Run("translate to the requested locale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := []string{"pt"}
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T(BindBody).Return("corpo do pedido inválido", nil).Times(1)
+		var requested []string
+		sut, _ := NewParser(func(l ...string) ut.Translator {
+			requested = l
+			return translator
+		})
+
+		resp, e := sut.ParseBinding(BindBody, fmt.Errorf("error message"), locale...)
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case !reflect.DeepEqual(requested, locale):
+			t.Errorf("requested the (%v) locales when expecting (%v)", requested, locale)
+		case resp.Status.Errors[0].GetMessage() != "corpo do pedido inválido":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		}
+	})
+}
+
+func Test_Parser_ParseParams(t *testing.T) {
+	t.Run("no-op on empty error list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut, _ := NewParser(staticLocalizer(NewMockTranslator(ctrl)))
+
+		if resp, e := sut.ParseParams(nil); resp != nil {
+			t.Error("returned an unexpectedly valid instance of a response")
+		} else if e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		}
+	})
+
+	t.Run("generating error with the default message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T("min", "count", "1").Return("", fmt.Errorf("error message")).Times(1)
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.ParseParams([]ParamError{{Name: "count", Tag: "min", Limit: "1", Code: 3}})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", resp.GetStatusCode())
+		case resp.Status.Errors[0].GetCode() != "p:3.c:102":
+			t.Errorf("returned the (%v) error code", resp.Status.Errors[0].GetCode())
+		case resp.Status.Errors[0].GetMessage() != "count must be at least 1":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		case resp.Status.Errors[0].Field != "count":
+			t.Errorf("returned the (%v) error field", resp.Status.Errors[0].Field)
+		}
+	})
+
+	t.Run("translate to the requested locale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := []string{"pt"}
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T("required", "count", "").Return("count é obrigatório", nil).Times(1)
+		var requested []string
+		sut, _ := NewParser(func(l ...string) ut.Translator {
+			requested = l
+			return translator
+		})
+
+		resp, e := sut.ParseParams([]ParamError{{Name: "count", Tag: "required"}}, locale...)
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case !reflect.DeepEqual(requested, locale):
+			t.Errorf("requested the (%v) locales when expecting (%v)", requested, locale)
+		case resp.Status.Errors[0].GetMessage() != "count é obrigatório":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		}
+	})
+}
+
+func Test_Parser_ParseSchema(t *testing.T) {
+	t.Run("no-op on empty error list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sut, _ := NewParser(staticLocalizer(NewMockTranslator(ctrl)))
+
+		if resp, e := sut.ParseSchema(nil); resp != nil {
+			t.Error("returned an unexpectedly valid instance of a response")
+		} else if e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		}
+	})
+
+	t.Run("generating error of the document root", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T("type", "body", "object").Return("", fmt.Errorf("error message")).Times(1)
+		sut, _ := NewParser(staticLocalizer(translator))
+
+		resp, e := sut.ParseSchema([]SchemaError{{Tag: "type", Limit: "object"}})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", resp.GetStatusCode())
+		case resp.Status.Errors[0].GetCode() != "c:120":
+			t.Errorf("returned the (%v) error code", resp.Status.Errors[0].GetCode())
+		case resp.Status.Errors[0].GetMessage() != "body must be of type object":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		case resp.Status.Errors[0].Field != "":
+			t.Errorf("returned the (%v) error field", resp.Status.Errors[0].Field)
+		}
+	})
+
+	t.Run("generating error with the overridden code and translated message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T("pattern", "/name", "^a$").Return("translated message", nil).Times(1)
+		sut, _ := NewParser(staticLocalizer(translator))
+		sut.SetCodes(map[string]int{"pattern": 500})
+
+		resp, e := sut.ParseSchema([]SchemaError{{Pointer: "/name", Tag: "pattern", Limit: "^a$"}})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.Status.Errors[0].GetCode() != "c:500":
+			t.Errorf("returned the (%v) error code", resp.Status.Errors[0].GetCode())
+		case resp.Status.Errors[0].GetMessage() != "translated message":
+			t.Errorf("returned the (%v) error message", resp.Status.Errors[0].GetMessage())
+		case resp.Status.Errors[0].Field != "/name":
+			t.Errorf("returned the (%v) error field", resp.Status.Errors[0].Field)
+		}
+	})
 }
 
 func Test_Parser_AddError(t *testing.T) {
@@ -242,11 +569,13 @@ func Test_Parser_AddError(t *testing.T) {
 		errMsg := "error message"
 		translator := NewMockTranslator(ctrl)
 		fieldError := NewMockFieldError(ctrl)
-		fieldError.EXPECT().StructField().Return("Field").Times(1)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
 		fieldError.EXPECT().Translate(translator).Return(errMsg).Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
 		fieldError.EXPECT().Tag().Return(mappedErrorName).Times(1)
 
-		sut, _ := NewParser(translator)
+		sut, _ := NewParser(staticLocalizer(translator))
 		sut.AddError(mappedErrorName, mappedErrorCode)
 
 		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
@@ -260,3 +589,119 @@ func Test_Parser_AddError(t *testing.T) {
 		}
 	})
 }
+
+func Test_Parser_SetCodes(t *testing.T) {
+	t.Run("override the mapped error codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		data := struct {
+			Field int `validate:"gt=0"`
+		}{Field: 0}
+		translator := NewMockTranslator(ctrl)
+		fieldError := NewMockFieldError(ctrl)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
+		fieldError.EXPECT().Translate(translator).Return("error message").Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
+		fieldError.EXPECT().Tag().Return("gt").Times(1)
+		codes := map[string]int{"gt": 500}
+
+		sut, _ := NewParser(staticLocalizer(translator))
+		sut.AddError("gt", 400)
+		sut.SetCodes(codes)
+		codes["gt"] = 600
+
+		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.Status.Errors[0].GetCode() != "c:500":
+			t.Errorf("returned the (%v) error code instead of the expected (c:500)", resp.Status.Errors[0].GetCode())
+		}
+	})
+
+	t.Run("restore the mapped error codes when the override is removed", func(t *testing.T) {
+		sut, _ := NewParser(staticLocalizer(nil))
+		sut.SetCodes(map[string]int{"gt": 500, "custom": 1000})
+		sut.SetCodes(map[string]int{})
+
+		codes := sut.Codes()
+		if codes["gt"] != 89 {
+			t.Errorf("returned the (%v) code when expecting (89)", codes["gt"])
+		} else if _, ok := codes["custom"]; ok {
+			t.Error("returned the removed custom code")
+		}
+	})
+}
+
+func Test_Parser_SetDefaultCode(t *testing.T) {
+	t.Run("assign the default code of the unmapped errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		data := struct {
+			Field int `validate:"gt=0"`
+		}{Field: 0}
+		translator := NewMockTranslator(ctrl)
+		fieldError := NewMockFieldError(ctrl)
+		fieldError.EXPECT().StructNamespace().Return("Field").Times(1)
+		fieldError.EXPECT().Value().Return(0).Times(1)
+		fieldError.EXPECT().Translate(translator).Return("error message").Times(1)
+		fieldError.EXPECT().Error().Return("Key: 'Field' Error:Field validation failed").Times(1)
+		fieldError.EXPECT().Tag().Return("unrecognized").Times(1)
+
+		sut, _ := NewParser(staticLocalizer(translator))
+		sut.SetDefaultCode(999)
+
+		resp, e := sut.Parse(data, []validator.FieldError{fieldError})
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case sut.DefaultCode() != 999:
+			t.Errorf("returned the (%v) default code", sut.DefaultCode())
+		case resp.Status.Errors[0].GetCode() != "c:999":
+			t.Errorf("returned the (%v) error code instead of the expected (c:999)", resp.Status.Errors[0].GetCode())
+		}
+	})
+
+	t.Run("assign the default code of the unmapped binding errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		translator := NewMockTranslator(ctrl)
+		translator.EXPECT().T("unrecognized").Return("error message", nil).Times(1)
+
+		sut, _ := NewParser(staticLocalizer(translator))
+		sut.SetDefaultCode(999)
+
+		resp, e := sut.ParseBinding("unrecognized", fmt.Errorf("error message"))
+		switch {
+		case e != nil:
+			t.Errorf("return the unexpected error (%v)", e)
+		case resp.Status.Errors[0].GetCode() != "c:999":
+			t.Errorf("returned the (%v) error code instead of the expected (c:999)", resp.Status.Errors[0].GetCode())
+		}
+	})
+}
+
+func Test_Parser_Codes(t *testing.T) {
+	t.Run("retrieve the effective error code table", func(t *testing.T) {
+		sut, _ := NewParser(staticLocalizer(nil))
+		sut.AddError("even", 1000)
+		sut.SetCodes(map[string]int{"required": 500, "odd": 1001})
+
+		codes := sut.Codes()
+		codes["gt"] = 0
+		switch {
+		case len(codes) != 129:
+			t.Errorf("returned (%v) codes when expecting 128", len(codes))
+		case codes["required"] != 500 || codes["even"] != 1000 || codes["odd"] != 1001:
+			t.Errorf("returned the unexpected (%v) codes", codes)
+		case sut.Codes()["gt"] != 89:
+			t.Error("returned a reference to the parser code table")
+		case sut.DefaultCode() != DefaultCode:
+			t.Errorf("returned the (%v) default code", sut.DefaultCode())
+		}
+	})
+}
@@ -1,12 +1,14 @@
 package validation
 
 import (
-	"github.com/go-playground/locales/en"
+	"strings"
+
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
-	translations "github.com/go-playground/validator/v10/translations/en"
 	"github.com/happyhippyhippo/slate"
 	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
 )
 
 const (
@@ -22,9 +24,53 @@ const (
 	// as the container registration id of a translator.
 	TranslatorID = ID + ".translator"
 
+	// LocalizerID defines the id to be used
+	// as the container registration id of a translator selection method.
+	LocalizerID = ID + ".localizer"
+
 	// ParserID defines the id to be used
 	// as the container registration id of an error parser instance.
 	ParserID = ID + ".parser"
+
+	// ValidateID defines the id to be used
+	// as the container registration id of the validate instance.
+	ValidateID = ID + ".validate"
+
+	// RuleTag defines the tag to be assigned to all
+	// container custom validation rules, as Rule or *Rule values.
+	RuleTag = ID + ".rule"
+
+	// BinderID defines the id to be used
+	// as the container registration id of a request binder.
+	BinderID = ID + ".binder"
+
+	// ParamValidatorID defines the id to be used
+	// as the container registration id of a request parameters validator.
+	ParamValidatorID = ID + ".params"
+
+	// SchemaRegistryID defines the id to be used
+	// as the container registration id of a JSON Schema registry.
+	SchemaRegistryID = ID + ".schema_registry"
+
+	// ResponseSchemaRegistryID defines the id to be used
+	// as the container registration id of an endpoint response JSON
+	// Schema registry.
+	ResponseSchemaRegistryID = ID + ".response_schema_registry"
+
+	// SchemaValidatorID defines the id to be used
+	// as the container registration id of a request body JSON Schema
+	// validator.
+	SchemaValidatorID = ID + ".schema"
+
+	// MiddlewareGeneratorID defines the id to be used
+	// as the container registration id of a validation middleware
+	// generator.
+	MiddlewareGeneratorID = ID + ".middleware"
+
+	// ResponseMiddlewareGeneratorID defines the id to be used
+	// as the container registration id of a response validation
+	// middleware generator.
+	ResponseMiddlewareGeneratorID = ID + ".response_middleware"
 )
 
 // Provider @todo doc
@@ -41,10 +87,9 @@ func (p Provider) Register(
 	if len(container) == 0 || container[0] == nil {
 		return errNilPointer("container")
 	}
-	// register a universal translator
-	_ = container[0].Service(UniversalTranslatorID, func() *ut.UniversalTranslator {
-		lang := en.New()
-		return ut.New(lang, lang)
+	// register a universal translator with all the supported locales
+	_ = container[0].Service(UniversalTranslatorID, func() (*ut.UniversalTranslator, error) {
+		return NewUniversalTranslator(Locale, strings.Split(Locales, ",")...)
 	})
 	// register a translator instance of the defined default locale
 	_ = container[0].Service(TranslatorID, func(universalTranslator *ut.UniversalTranslator) (ut.Translator, error) {
@@ -54,18 +99,52 @@ func (p Provider) Register(
 		}
 		return translator, nil
 	})
+	// register a request locale translator selection method
+	_ = container[0].Service(LocalizerID, NewLocalizer)
 	// register a validation error parser
 	_ = container[0].Service(ParserID, NewParser)
-	// register a validation method service
-	_ = container[0].Service(ID, func(translator ut.Translator, parser IParser) (Validator, error) {
+	// register the validate instance with the supported locales messages
+	_ = container[0].Service(ValidateID, func(universalTranslator *ut.UniversalTranslator) (*validator.Validate, error) {
 		validate := validator.New()
-		_ = translations.RegisterDefaultTranslations(validate, translator)
-		return NewValidator(validate, parser)
+		if e := RegisterTranslations(validate, universalTranslator); e != nil {
+			return nil, e
+		}
+		return validate, nil
+	})
+	// register a validation method service
+	_ = container[0].Service(ID, NewValidator)
+	// register a request binding method service
+	_ = container[0].Service(BinderID, NewBinder)
+	// register a request parameters validation method service
+	_ = container[0].Service(ParamValidatorID, NewParamValidator)
+	// register the JSON Schema registry and validation method service
+	_ = container[0].Service(SchemaRegistryID, NewSchemaRegistry)
+	_ = container[0].Service(SchemaValidatorID, NewSchemaValidator)
+	// register the endpoint validation middleware generator
+	_ = container[0].Service(MiddlewareGeneratorID, NewMiddlewareGenerator)
+	// register the endpoint response JSON Schema registry
+	_ = container[0].Service(ResponseSchemaRegistryID, NewSchemaRegistry)
+	// register the development mode response validation middleware generator
+	// (the response schema registry is retrieved by id because it shares
+	// the request schema registry type)
+	_ = container[0].Service(ResponseMiddlewareGeneratorID, func(
+		cfg config.IManager,
+		logger log.ILog,
+		validate *validator.Validate,
+		parser IParser,
+	) (ResponseMiddlewareGenerator, error) {
+		schemas, e := p.getResponseSchemaRegistry(container[0])
+		if e != nil {
+			return nil, e
+		}
+		return NewResponseMiddlewareGenerator(cfg, logger, validate, parser, schemas)
 	})
 	return nil
 }
 
-// Boot will start the validation package
+// Boot will start the validation package by loading the configured
+// parser error codes and JSON Schemas, if the config manager is provided,
+// and registering all the container custom validation rules.
 func (p Provider) Boot(
 	container ...slate.IContainer,
 ) error {
@@ -73,5 +152,198 @@ func (p Provider) Boot(
 	if len(container) == 0 || container[0] == nil {
 		return errNilPointer("container")
 	}
+	parser, e := p.getParser(container[0])
+	if e != nil {
+		return e
+	}
+	// load and observe the configured parser error codes and endpoint
+	// JSON Schemas, only if the config manager is provided
+	if container[0].Has(config.ID) {
+		if e := p.observeConfig(container[0], parser); e != nil {
+			return e
+		}
+	}
+	// retrieve the registered custom rules
+	rules, e := p.getRules(container[0])
+	if e != nil {
+		return e
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	// retrieve the rule registration dependencies
+	validate, e := p.getValidate(container[0])
+	if e != nil {
+		return e
+	}
+	universalTranslator, e := p.getUniversalTranslator(container[0])
+	if e != nil {
+		return e
+	}
+	// register the custom rules
+	for _, rule := range rules {
+		if e := RegisterRule(validate, universalTranslator, parser, rule); e != nil {
+			return e
+		}
+	}
 	return nil
 }
+
+func (p Provider) observeConfig(
+	container slate.IContainer,
+	parser IParser,
+) error {
+	cfg, e := p.getConfig(container)
+	if e != nil {
+		return e
+	}
+	logger, e := p.getLogger(container)
+	if e != nil {
+		return e
+	}
+	// load and observe the configured parser error codes
+	if e := ObserveCodes(parser, cfg, logger); e != nil {
+		return e
+	}
+	// load and observe the configured endpoint JSON Schemas
+	registry, e := p.getSchemaRegistry(container)
+	if e != nil {
+		return e
+	}
+	return ObserveSchemas(registry, cfg, logger)
+}
+
+func (Provider) getConfig(
+	container slate.IContainer,
+) (config.IManager, error) {
+	// retrieve the config entry
+	entry, e := container.Get(config.ID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(config.IManager)
+	if !ok {
+		return nil, errConversion(entry, "config.IManager")
+	}
+	return instance, nil
+}
+
+func (Provider) getLogger(
+	container slate.IContainer,
+) (log.ILog, error) {
+	// retrieve the logger entry
+	entry, e := container.Get(log.ID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(log.ILog)
+	if !ok {
+		return nil, errConversion(entry, "log.ILog")
+	}
+	return instance, nil
+}
+
+func (Provider) getValidate(
+	container slate.IContainer,
+) (*validator.Validate, error) {
+	// retrieve the validate entry
+	entry, e := container.Get(ValidateID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(*validator.Validate)
+	if !ok {
+		return nil, errConversion(entry, "*validator.Validate")
+	}
+	return instance, nil
+}
+
+func (Provider) getUniversalTranslator(
+	container slate.IContainer,
+) (*ut.UniversalTranslator, error) {
+	// retrieve the universal translator entry
+	entry, e := container.Get(UniversalTranslatorID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(*ut.UniversalTranslator)
+	if !ok {
+		return nil, errConversion(entry, "*ut.UniversalTranslator")
+	}
+	return instance, nil
+}
+
+func (Provider) getParser(
+	container slate.IContainer,
+) (IParser, error) {
+	// retrieve the parser entry
+	entry, e := container.Get(ParserID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(IParser)
+	if !ok {
+		return nil, errConversion(entry, "validation.IParser")
+	}
+	return instance, nil
+}
+
+func (Provider) getSchemaRegistry(
+	container slate.IContainer,
+) (ISchemaRegistry, error) {
+	// retrieve the schema registry entry
+	entry, e := container.Get(SchemaRegistryID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(ISchemaRegistry)
+	if !ok {
+		return nil, errConversion(entry, "validation.ISchemaRegistry")
+	}
+	return instance, nil
+}
+
+func (Provider) getResponseSchemaRegistry(
+	container slate.IContainer,
+) (ISchemaRegistry, error) {
+	// retrieve the response schema registry entry
+	entry, e := container.Get(ResponseSchemaRegistryID)
+	if e != nil {
+		return nil, e
+	}
+	// validate the retrieved entry type
+	instance, ok := entry.(ISchemaRegistry)
+	if !ok {
+		return nil, errConversion(entry, "validation.ISchemaRegistry")
+	}
+	return instance, nil
+}
+
+func (Provider) getRules(
+	container slate.IContainer,
+) ([]Rule, error) {
+	// retrieve the rules entries
+	entries, e := container.Tag(RuleTag)
+	if e != nil {
+		return nil, e
+	}
+	// type check the retrieved rules
+	var rules []Rule
+	for _, entry := range entries {
+		switch instance := entry.(type) {
+		case Rule:
+			rules = append(rules, instance)
+		case *Rule:
+			if instance != nil {
+				rules = append(rules, *instance)
+			}
+		}
+	}
+	return rules, nil
+}
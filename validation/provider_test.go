@@ -5,7 +5,11 @@ import (
 	"testing"
 
 	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
 	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
 	"github.com/pkg/errors"
 )
 
@@ -38,10 +42,28 @@ func Test_Provider_Register(t *testing.T) {
 			t.Errorf("didn't registered the universal translator : %v", sut)
 		case !container.Has(TranslatorID):
 			t.Errorf("didn't registered the translator : %v", sut)
+		case !container.Has(LocalizerID):
+			t.Errorf("didn't registered the localizer : %v", sut)
 		case !container.Has(ParserID):
 			t.Errorf("didn't registered the error parser : %v", sut)
+		case !container.Has(ValidateID):
+			t.Errorf("didn't registered the validate instance : %v", sut)
 		case !container.Has(ID):
 			t.Errorf("didn't registered the validator : %v", sut)
+		case !container.Has(BinderID):
+			t.Errorf("didn't registered the binder : %v", sut)
+		case !container.Has(ParamValidatorID):
+			t.Errorf("didn't registered the parameters validator : %v", sut)
+		case !container.Has(SchemaRegistryID):
+			t.Errorf("didn't registered the schema registry : %v", sut)
+		case !container.Has(ResponseSchemaRegistryID):
+			t.Errorf("didn't registered the response schema registry : %v", sut)
+		case !container.Has(SchemaValidatorID):
+			t.Errorf("didn't registered the schema validator : %v", sut)
+		case !container.Has(MiddlewareGeneratorID):
+			t.Errorf("didn't registered the middleware generator : %v", sut)
+		case !container.Has(ResponseMiddlewareGeneratorID):
+			t.Errorf("didn't registered the response middleware generator : %v", sut)
 		}
 	})
 
@@ -64,6 +86,19 @@ func Test_Provider_Register(t *testing.T) {
 		}
 	})
 
+	t.Run("error instantiating universal translator with unsupported locale list", func(t *testing.T) {
+		Locales = "en,unsupported"
+		defer func() { Locales = "en,es,fr,it,nl,pt,pt_BR" }()
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		if _, e := container.Get(UniversalTranslatorID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
 	t.Run("error retrieving universal translator when retrieving translator", func(t *testing.T) {
 		expected := fmt.Errorf("error message")
 		container := slate.NewContainer()
@@ -110,6 +145,42 @@ func Test_Provider_Register(t *testing.T) {
 		}
 	})
 
+	t.Run("error instantiating translator when retrieving localizer", func(t *testing.T) {
+		locale := "unsupported"
+		Locale = locale
+		defer func() { Locale = "en" }()
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		if _, e := container.Get(LocalizerID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("retrieving localizer", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		localizer, e := container.Get(LocalizerID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case localizer == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch l := localizer.(type) {
+			case Localizer:
+				if check := l("pt-PT").Locale(); check != "pt" {
+					t.Errorf("selected the (%v) locale when expecting (pt)", check)
+				}
+			default:
+				t.Error("didn't returned the localizer reference")
+			}
+		}
+	})
+
 	t.Run("error instantiating translator when retrieving parser", func(t *testing.T) {
 		locale := "unsupported"
 		Locale = locale
@@ -188,6 +259,210 @@ func Test_Provider_Register(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("error instantiating universal translator when retrieving validate", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = container.Service(UniversalTranslatorID, func() (*ut.UniversalTranslator, error) { return nil, expected })
+
+		if _, e := container.Get(ValidateID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("retrieving validate", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		validate, e := container.Get(ValidateID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case validate == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch validate.(type) {
+			case *validator.Validate:
+			default:
+				t.Error("didn't returned the validate reference")
+			}
+		}
+	})
+
+	t.Run("retrieving validator with the supported locales messages", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		data := struct {
+			Field string `validate:"required"`
+		}{}
+		expected := map[string]string{
+			"":   "Field is a required field",
+			"en": "Field is a required field",
+			"es": "Field es un campo requerido",
+			"pt": "Field é obrigatório",
+		}
+
+		instance, _ := container.Get(ID)
+		v := instance.(Validator)
+		for locale, message := range expected {
+			env, e := v(&data, locale)
+			switch {
+			case e != nil:
+				t.Errorf("returned the unexpected error (%v)", e)
+			case env == nil:
+				t.Error("didn't returned the expected envelope")
+			case env.Status.Errors[0].Message != message:
+				t.Errorf("returned the (%v) message for the (%v) locale when expecting (%v)", env.Status.Errors[0].Message, locale, message)
+			}
+		}
+	})
+
+	t.Run("error instantiating validator when retrieving binder", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = container.Service(ID, func() (Validator, error) { return nil, expected })
+
+		if _, e := container.Get(BinderID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("retrieving binder", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		binder, e := container.Get(BinderID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case binder == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch binder.(type) {
+			case Binder:
+			default:
+				t.Error("didn't returned the binder reference")
+			}
+		}
+	})
+
+	t.Run("retrieving parameters validator", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		validator, e := container.Get(ParamValidatorID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case validator == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch validator.(type) {
+			case ParamValidator:
+			default:
+				t.Error("didn't returned the parameters validator reference")
+			}
+		}
+	})
+
+	t.Run("retrieving schema validator", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+
+		validator, e := container.Get(SchemaValidatorID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case validator == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch validator.(type) {
+			case SchemaValidator:
+			default:
+				t.Error("didn't returned the schema validator reference")
+			}
+		}
+	})
+
+	t.Run("retrieving middleware generator", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+
+		generator, e := container.Get(MiddlewareGeneratorID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case generator == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch generator.(type) {
+			case MiddlewareGenerator:
+			default:
+				t.Error("didn't returned the middleware generator reference")
+			}
+		}
+	})
+
+	t.Run("retrieving response middleware generator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(ResponseSchemasConfigPath, config.Config{}).Return(config.Config{"users.get": config.Config{"type": "object"}}, nil).Times(1)
+		cfg.EXPECT().AddObserver(ResponseSchemasConfigPath, gomock.Any()).Return(nil).Times(1)
+		logger := NewMockLog(ctrl)
+
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return cfg })
+		_ = container.Service(log.ID, func() log.ILog { return logger })
+
+		generator, e := container.Get(ResponseMiddlewareGeneratorID)
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case generator == nil:
+			t.Error("didn't returned a valid reference")
+		default:
+			switch generator.(type) {
+			case ResponseMiddlewareGenerator:
+				responseSchemas, _ := container.Get(ResponseSchemaRegistryID)
+				schemas, _ := container.Get(SchemaRegistryID)
+				if !responseSchemas.(ISchemaRegistry).Has("users.get") {
+					t.Error("didn't loaded the response schemas into the response schema registry")
+				} else if schemas.(ISchemaRegistry).Has("users.get") {
+					t.Error("loaded the response schemas into the request schema registry")
+				}
+			default:
+				t.Error("didn't returned the response middleware generator reference")
+			}
+		}
+	})
+
+	t.Run("error retrieving response schema registry when retrieving response middleware generator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return NewMockConfigManager(ctrl) })
+		_ = container.Service(log.ID, func() log.ILog { return NewMockLog(ctrl) })
+		_ = container.Service(ResponseSchemaRegistryID, func() string { return "string" })
+
+		if _, e := container.Get(ResponseMiddlewareGeneratorID); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
 }
 
 func Test_Provider_Boot(t *testing.T) {
@@ -209,10 +484,348 @@ func Test_Provider_Boot(t *testing.T) {
 
 	t.Run("successful boot", func(t *testing.T) {
 		app := slate.NewApplication()
+		_ = app.Provide(config.Provider{})
+		_ = app.Provide(log.Provider{})
 		_ = app.Provide(Provider{})
 
 		if e := app.Boot(); e != nil {
 			t.Errorf("returned the (%v) error", e)
 		}
 	})
+
+	t.Run("successful boot without config", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else {
+			instance, _ := container.Get(ParserID)
+			parser := instance.(IParser)
+			if check := parser.DefaultCode(); check != DefaultCode {
+				t.Errorf("stored the (%v) default code when expecting (%v)", check, DefaultCode)
+			} else if _, ok := parser.Codes()["even"]; !ok {
+				t.Error("didn't registered the container rule")
+			}
+		}
+	})
+
+	t.Run("error retrieving parser", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		expected := fmt.Errorf("error message")
+		_ = container.Service(ParserID, func() (IParser, error) { return nil, expected })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("error retrieving config", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		expected := fmt.Errorf("error message")
+		_ = container.Service(config.ID, func() (config.IManager, error) { return nil, expected })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(config.ID, func() string { return "string" })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error retrieving logger", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		expected := fmt.Errorf("error message")
+		_ = container.Service(log.ID, func() (log.ILog, error) { return nil, expected })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid logger", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = container.Service(log.ID, func() string { return "string" })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error loading the configured codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(nil, expected).Times(1)
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return cfg })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if e.Error() != expected.Error() {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("load the configured codes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{"even": 2000}, nil).Times(1)
+		cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(999, nil).Times(1)
+		cfg.EXPECT().AddObserver(CodesConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().AddObserver(DefaultCodeConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{}, nil).Times(1)
+		cfg.EXPECT().AddObserver(SchemasConfigPath, gomock.Any()).Return(nil).Times(1)
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return cfg })
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else {
+			instance, _ := container.Get(ParserID)
+			parser := instance.(IParser)
+			if check := parser.Codes()["even"]; check != 2000 {
+				t.Errorf("stored the (%v) rule code when expecting the configured (2000)", check)
+			} else if check := parser.DefaultCode(); check != 999 {
+				t.Errorf("stored the (%v) default code when expecting (999)", check)
+			}
+		}
+	})
+
+	t.Run("error retrieving schema registry", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(SchemaRegistryID, func() string { return "string" })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("error loading the configured schemas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{}, nil).Times(1)
+		cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(0, nil).Times(1)
+		cfg.EXPECT().AddObserver(CodesConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().AddObserver(DefaultCodeConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"endpoint": 123}, nil).Times(1)
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return cfg })
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("load the configured schemas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Config(CodesConfigPath, config.Config{}).Return(config.Config{}, nil).Times(1)
+		cfg.EXPECT().Int(DefaultCodeConfigPath, DefaultCode).Return(0, nil).Times(1)
+		cfg.EXPECT().AddObserver(CodesConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().AddObserver(DefaultCodeConfigPath, gomock.Any()).Return(nil).Times(1)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"users.create": config.Config{"type": "object"}}, nil).Times(1)
+		cfg.EXPECT().AddObserver(SchemasConfigPath, gomock.Any()).Return(nil).Times(1)
+		container := slate.NewContainer()
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(config.ID, func() config.IManager { return cfg })
+
+		if e := (&Provider{}).Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else {
+			instance, _ := container.Get(SchemaRegistryID)
+			if !instance.(ISchemaRegistry).Has("users.create") {
+				t.Error("didn't loaded the configured schema")
+			}
+		}
+	})
+
+	t.Run("error retrieving rules", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		expected := fmt.Errorf("error message")
+		_ = container.Service("rule", func() (Rule, error) { return Rule{}, expected }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("error retrieving validate", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		expected := fmt.Errorf("error message")
+		_ = container.Service(ValidateID, func() (*validator.Validate, error) { return nil, expected })
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrContainer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrContainer)
+		}
+	})
+
+	t.Run("invalid validate", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(ValidateID, func() string { return "string" })
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid universal translator", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(ValidateID, func() *validator.Validate { return validator.New() })
+		_ = container.Service(UniversalTranslatorID, func() string { return "string" })
+		_ = container.Service(ParserID, func() (IParser, error) { return NewParser(staticLocalizer(nil)) })
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid parser", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service(ParserID, func() string { return "string" })
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service("rule", func() Rule { return Rule{Tag: "even"} }, RuleTag)
+
+		if e := (&Provider{}).Boot(container); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidRule)
+		}
+	})
+
+	t.Run("register the container rules", func(t *testing.T) {
+		container := slate.NewContainer()
+		_ = (&config.Provider{}).Register(container)
+		_ = (&Provider{}).Register(container)
+		_ = (&log.Provider{}).Register(container)
+		_ = container.Service("rule", func() Rule { return testRule() }, RuleTag)
+		_ = container.Service("rule pointer", func() *Rule {
+			rule := testRule()
+			rule.Tag = "odd"
+			rule.Func = func(fl validator.FieldLevel) bool { return fl.Field().Int()%2 != 0 }
+			rule.Messages = map[string]string{"en": "{0} must be odd"}
+			rule.Code = 1001
+			return &rule
+		}, RuleTag)
+		_ = container.Service("nil rule pointer", func() *Rule { return nil }, RuleTag)
+		_ = container.Service("not a rule", func() string { return "string" }, RuleTag)
+
+		data := struct {
+			Field int `validate:"even"`
+			Other int `validate:"odd"`
+		}{Field: 1, Other: 2}
+
+		if e := (&Provider{}).Boot(container); e != nil {
+			t.Errorf("returned the (%v) error", e)
+		} else {
+			instance, _ := container.Get(ID)
+			env, e := instance.(Validator)(&data, "pt")
+			switch {
+			case e != nil:
+				t.Errorf("returned the unexpected error (%v)", e)
+			case env == nil:
+				t.Error("didn't returned the expected envelope")
+			case env.Status.Errors[0].GetCode() != "c:1000":
+				t.Errorf("returned the (%v) error code", env.Status.Errors[0].GetCode())
+			case env.Status.Errors[0].GetMessage() != "Field deve ser par":
+				t.Errorf("returned the (%v) error message", env.Status.Errors[0].GetMessage())
+			case len(env.Status.Errors) != 2:
+				t.Errorf("returned the (%v) errors", env.Status.Errors)
+			case env.Status.Errors[1].GetCode() != "c:1001":
+				t.Errorf("returned the (%v) pointer rule error code", env.Status.Errors[1].GetCode())
+			case env.Status.Errors[1].GetMessage() != "Other must be odd":
+				t.Errorf("returned the (%v) pointer rule error message", env.Status.Errors[1].GetMessage())
+			}
+		}
+	})
 }
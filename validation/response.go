@@ -0,0 +1,156 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/happyhippyhippo/slate-rest"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+// ResponseMiddlewareGenerator is a function type used to define a
+// calling interface of function responsible to generate an endpoint
+// response validation middleware.
+type ResponseMiddlewareGenerator func(id string) (rest.Middleware, error)
+
+// NewResponseMiddlewareGenerator returns a development mode response
+// validation middleware generator function. The generated middleware
+// validates the data of the successful endpoint response envelopes
+// against the endpoint response JSON Schema, if one is configured, or
+// against the data structure validation tags. The configured response
+// schemas are loaded and observed into the given schema registry. The
+// violations are logged, and the response replaced by an internal
+// server error envelope if the failure mode is enabled. The middleware must be executed within the
+// envelope middleware, and the validation is only active if enabled by
+// the environment and gin is not running in release mode.
+func NewResponseMiddlewareGenerator(
+	cfg config.IManager,
+	logger log.ILog,
+	validate *validator.Validate,
+	parser IParser,
+	schemas ISchemaRegistry,
+) (ResponseMiddlewareGenerator, error) {
+	// check the config argument reference
+	if cfg == nil {
+		return nil, errNilPointer("cfg")
+	}
+	// check the logger argument reference
+	if logger == nil {
+		return nil, errNilPointer("logger")
+	}
+	// check the validate argument reference
+	if validate == nil {
+		return nil, errNilPointer("validate")
+	}
+	// check the parser argument reference
+	if parser == nil {
+		return nil, errNilPointer("parser")
+	}
+	// check the schemas argument reference
+	if schemas == nil {
+		return nil, errNilPointer("schemas")
+	}
+	// validate log level
+	logLevel, ok := log.LevelMap[LogLevel]
+	if !ok {
+		logLevel = log.WARNING
+	}
+	// load and observe the configured endpoint response schemas
+	if e := observeSchemas(schemas, cfg, logger, ResponseSchemasConfigPath); e != nil {
+		return nil, e
+	}
+	// return the middleware generator
+	return func(
+		id string,
+	) (rest.Middleware, error) {
+		// return the generated middleware function
+		return func(
+			next gin.HandlerFunc,
+		) gin.HandlerFunc {
+			// return the middleware handler function
+			return func(
+				ctx *gin.Context,
+			) {
+				// execute the middleware stored execution method
+				next(ctx)
+				// check if the response validation is active
+				if !ResponseValidation || gin.Mode() == gin.ReleaseMode {
+					return
+				}
+				// retrieve the successful response envelope
+				var response *envelope.Envelope
+				value, _ := ctx.Get("response")
+				switch v := value.(type) {
+				case *envelope.Envelope:
+					response = v
+				case envelope.IEnvelope:
+					response = v.Envelope()
+				}
+				if response == nil || response.Data == nil || (response.Status != nil && !response.Status.Success) {
+					return
+				}
+				// validate the response data
+				violations, e := validateResponse(schemas, validate, parser, id, response.Data)
+				if e == nil && violations == nil {
+					return
+				}
+				// log and report the response violations
+				context := log.Context{"endpoint": id}
+				if e != nil {
+					context["error"] = e
+				} else {
+					context["errors"] = violations.Status.Errors
+				}
+				_ = logger.Signal(LogChannel, logLevel, LogResponseErrorMessage, context)
+				if !ResponseValidationFail {
+					return
+				}
+				if violations == nil {
+					violations = envelope.NewEnvelope(0, nil).AddError(envelope.NewStatusError(0, "invalid response"))
+				}
+				violations.StatusCode = http.StatusInternalServerError
+				ctx.Set("response", violations)
+			}
+		}, nil
+	}, nil
+}
+
+func validateResponse(
+	schemas ISchemaRegistry,
+	validate *validator.Validate,
+	parser IParser,
+	id string,
+	data interface{},
+) (*envelope.Envelope, error) {
+	// validate the data against the endpoint response schema
+	if schema, e := schemas.Get(id); e == nil {
+		encoded, e := json.Marshal(data)
+		if e != nil {
+			return nil, e
+		}
+		errs, e := schema.Validate(encoded)
+		if e != nil {
+			return nil, e
+		}
+		return parser.ParseSchema(errs)
+	}
+	// validate the data structure tags, diving into the collections
+	var e error
+	switch reflect.Indirect(reflect.ValueOf(data)).Kind() {
+	case reflect.Struct:
+		e = validate.Struct(data)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		e = validate.Var(data, "dive")
+	}
+	var errs validator.ValidationErrors
+	if !errors.As(e, &errs) {
+		return nil, nil
+	}
+	return parser.Parse(data, errs)
+}
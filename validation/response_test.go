@@ -0,0 +1,250 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+type responseTestData struct {
+	ID   int    `json:"id" validate:"gt=0"`
+	Name string `json:"name" validate:"required"`
+}
+
+func newTestResponseConfig(
+	ctrl *gomock.Controller,
+	schemas config.Config,
+) config.IManager {
+	cfg := NewMockConfigManager(ctrl)
+	cfg.EXPECT().Get(ResponseSchemasConfigPath, config.Config{}).Return(schemas, nil).AnyTimes()
+	cfg.EXPECT().AddObserver(ResponseSchemasConfigPath, gomock.Any()).Return(nil).AnyTimes()
+	return cfg
+}
+
+func newTestResponseParser() IParser {
+	translator, _ := ut.New(en.New(), en.New()).GetTranslator("en")
+	parser, _ := NewParser(staticLocalizer(translator))
+	return parser
+}
+
+func runTestResponseMiddleware(
+	generator ResponseMiddlewareGenerator,
+	mode string,
+	response interface{},
+) interface{} {
+	ctx := newTestBinderContext(http.MethodGet, "/", "", "", nil)
+	gin.SetMode(mode)
+	defer gin.SetMode(gin.ReleaseMode)
+	mw, _ := generator("endpoint")
+	mw(func(ctx *gin.Context) {
+		ctx.Set("response", response)
+	})(ctx)
+	result, _ := ctx.Get("response")
+	return result
+}
+
+func Test_NewResponseMiddlewareGenerator(t *testing.T) {
+	t.Run("nil arguments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		logger := NewMockLog(ctrl)
+		validate := validator.New()
+		parser := NewMockParser(ctrl)
+		schemas := NewSchemaRegistry()
+
+		scenarios := []struct {
+			test     string
+			cfg      config.IManager
+			logger   log.ILog
+			validate *validator.Validate
+			parser   IParser
+			schemas  ISchemaRegistry
+		}{
+			{test: "nil config", logger: logger, validate: validate, parser: parser, schemas: schemas},
+			{test: "nil logger", cfg: cfg, validate: validate, parser: parser, schemas: schemas},
+			{test: "nil validate", cfg: cfg, logger: logger, parser: parser, schemas: schemas},
+			{test: "nil parser", cfg: cfg, logger: logger, validate: validate, schemas: schemas},
+			{test: "nil schemas", cfg: cfg, logger: logger, validate: validate, parser: parser},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				generator, e := NewResponseMiddlewareGenerator(s.cfg, s.logger, s.validate, s.parser, s.schemas)
+				switch {
+				case generator != nil:
+					t.Error("returned a valid reference")
+				case e == nil:
+					t.Error("didn't returned the expected error")
+				case !errors.Is(e, slate.ErrNilPointer):
+					t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+				}
+			})
+		}
+	})
+
+	t.Run("error loading the response schemas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(ResponseSchemasConfigPath, config.Config{}).Return(config.Config{"endpoint": 1}, nil).Times(1)
+
+		if _, e := NewResponseMiddlewareGenerator(cfg, NewMockLog(ctrl), validator.New(), NewMockParser(ctrl), NewSchemaRegistry()); !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("no-op if the response validation is disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ResponseValidation = false
+		response := envelope.NewEnvelope(http.StatusOK, responseTestData{})
+		generator, _ := NewResponseMiddlewareGenerator(newTestResponseConfig(ctrl, config.Config{}), NewMockLog(ctrl), validator.New(), newTestResponseParser(), NewSchemaRegistry())
+
+		if check := runTestResponseMiddleware(generator, gin.TestMode, response); check != response {
+			t.Errorf("replaced the response with (%v)", check)
+		}
+	})
+
+	t.Run("no-op in release mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ResponseValidation, ResponseValidationFail = true, true
+		defer func() { ResponseValidation, ResponseValidationFail = false, false }()
+		response := envelope.NewEnvelope(http.StatusOK, responseTestData{})
+		generator, _ := NewResponseMiddlewareGenerator(newTestResponseConfig(ctrl, config.Config{}), NewMockLog(ctrl), validator.New(), newTestResponseParser(), NewSchemaRegistry())
+
+		if check := runTestResponseMiddleware(generator, gin.ReleaseMode, response); check != response {
+			t.Errorf("replaced the response with (%v)", check)
+		}
+	})
+
+	t.Run("validate the response data", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			schemas  config.Config
+			response interface{}
+			fail     bool
+			logged   bool
+			replaced bool
+			code     string
+		}{
+			{ // non envelope response
+				test:     "non envelope response",
+				response: fmt.Errorf("error message"),
+				fail:     true,
+			},
+			{ // error envelope response
+				test:     "error envelope response",
+				response: envelope.NewEnvelope(http.StatusNotFound, nil).AddError(envelope.NewStatusError(1, "not found")),
+				fail:     true,
+			},
+			{ // valid structure data
+				test:     "valid structure data",
+				response: envelope.NewEnvelope(http.StatusOK, &responseTestData{ID: 1, Name: "john"}),
+				fail:     true,
+			},
+			{ // non structure data
+				test:     "non structure data",
+				response: envelope.NewEnvelope(http.StatusOK, "data"),
+				fail:     true,
+			},
+			{ // logged structure violation
+				test:     "logged structure violation",
+				response: envelope.NewEnvelope(http.StatusOK, responseTestData{Name: "john"}),
+				logged:   true,
+			},
+			{ // failed structure violation
+				test:     "failed structure violation",
+				response: envelope.NewEnvelope(http.StatusOK, responseTestData{Name: "john"}),
+				fail:     true,
+				logged:   true,
+				replaced: true,
+				code:     "c:89",
+			},
+			{ // failed collection violation
+				test:     "failed collection violation",
+				response: envelope.NewTypedEnvelope(http.StatusOK, []responseTestData{{ID: 1, Name: "john"}, {ID: 2}}),
+				fail:     true,
+				logged:   true,
+				replaced: true,
+				code:     "c:104",
+			},
+			{ // valid schema data
+				test:     "valid schema data",
+				schemas:  config.Config{"endpoint": config.Config{"required": []interface{}{"id"}}},
+				response: envelope.NewEnvelope(http.StatusOK, responseTestData{}),
+				fail:     true,
+			},
+			{ // failed schema violation
+				test:     "failed schema violation",
+				schemas:  config.Config{"endpoint": config.Config{"properties": config.Config{"id": config.Config{"type": "string"}}}},
+				response: envelope.NewEnvelope(http.StatusOK, responseTestData{ID: 1, Name: "john"}),
+				fail:     true,
+				logged:   true,
+				replaced: true,
+				code:     "c:120",
+			},
+			{ // failed data encoding
+				test:     "failed data encoding",
+				schemas:  config.Config{"endpoint": config.Config{}},
+				response: envelope.NewEnvelope(http.StatusOK, map[string]interface{}{"channel": make(chan int)}),
+				fail:     true,
+				logged:   true,
+				replaced: true,
+				code:     "c:0",
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ResponseValidation, ResponseValidationFail = true, s.fail
+				defer func() { ResponseValidation, ResponseValidationFail = false, false }()
+				schemas := s.schemas
+				if schemas == nil {
+					schemas = config.Config{}
+				}
+				logger := NewMockLog(ctrl)
+				if s.logged {
+					logger.EXPECT().Signal(LogChannel, log.WARNING, LogResponseErrorMessage, gomock.Any()).Return(nil).Times(1)
+				}
+				generator, _ := NewResponseMiddlewareGenerator(newTestResponseConfig(ctrl, schemas), logger, validator.New(), newTestResponseParser(), NewSchemaRegistry())
+
+				check := runTestResponseMiddleware(generator, gin.TestMode, s.response)
+				if !s.replaced {
+					if check != s.response {
+						t.Errorf("replaced the response with (%v)", check)
+					}
+					return
+				}
+				env, ok := check.(*envelope.Envelope)
+				switch {
+				case !ok:
+					t.Errorf("stored the (%v) response", check)
+				case env.GetStatusCode() != http.StatusInternalServerError:
+					t.Errorf("stored the (%v) status code", env.GetStatusCode())
+				case env.Status.Errors[0].GetCode() != s.code:
+					t.Errorf("stored the (%v) error code when expecting (%v)", env.Status.Errors[0].GetCode(), s.code)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Rule defines a custom validation rule to be registered in the
+// application validator, identified by the validation tag used in the
+// structure field validate tags. The messages map holds the translation
+// message of each locale, where {0} is replaced by the field name and
+// {1} by the rule parameter. A non-zero code will be assigned as the
+// envelope error code of the rule validation errors.
+type Rule struct {
+	Tag      string
+	Func     validator.Func
+	Messages map[string]string
+	Code     int
+}
+
+// RegisterRule will register a custom validation rule in the given
+// validate instance, along with the rule messages of all the locales
+// supported by the universal translator and the rule error code
+// in the given parser. The locales without a rule message will use
+// the message of the universal translator default locale.
+func RegisterRule(
+	validate *validator.Validate,
+	universalTranslator *ut.UniversalTranslator,
+	parser IParser,
+	rule Rule,
+) error {
+	// check validate argument reference
+	if validate == nil {
+		return errNilPointer("validate")
+	}
+	// check universal translator argument reference
+	if universalTranslator == nil {
+		return errNilPointer("universalTranslator")
+	}
+	// check parser argument reference
+	if parser == nil {
+		return errNilPointer("parser")
+	}
+	// check the rule definition
+	if rule.Tag == "" || rule.Func == nil {
+		return errInvalidRule(rule.Tag)
+	}
+	// register the rule validation function
+	if e := registerValidation(validate, rule); e != nil {
+		return errInvalidRule(rule.Tag, map[string]interface{}{"error": e})
+	}
+	// register the rule messages of the locales found in the translator,
+	// where the locales without a message use the default locale message
+	fallback := ruleFallbackMessage(universalTranslator, rule.Messages)
+	locales := map[string]bool{}
+	for l := range supportedLocales {
+		locales[l] = true
+	}
+	for l := range rule.Messages {
+		locales[l] = true
+	}
+	for locale := range locales {
+		translator, found := universalTranslator.GetTranslator(locale)
+		if !found {
+			continue
+		}
+		msg, ok := rule.Messages[locale]
+		if !ok {
+			msg = fallback
+		}
+		if msg == "" {
+			continue
+		}
+		if e := validate.RegisterTranslation(
+			rule.Tag,
+			translator,
+			func(t ut.Translator) error {
+				return t.Add(rule.Tag, msg, true)
+			},
+			func(t ut.Translator, fe validator.FieldError) string {
+				translated, _ := t.T(fe.Tag(), fe.Field(), fe.Param())
+				return translated
+			},
+		); e != nil {
+			return e
+		}
+	}
+	// register the rule error code
+	if rule.Code != 0 {
+		parser.AddError(rule.Tag, rule.Code)
+	}
+	return nil
+}
+
+// ruleFallbackMessage will select the rule message used by the locales
+// without a message, being the message of the universal translator
+// default locale or, if not defined, the message of the first locale
+// in alphabetical order.
+func ruleFallbackMessage(
+	universalTranslator *ut.UniversalTranslator,
+	messages map[string]string,
+) string {
+	if fallback := universalTranslator.GetFallback(); fallback != nil {
+		if msg, ok := messages[fallback.Locale()]; ok {
+			return msg
+		}
+	}
+	locales := make([]string, 0, len(messages))
+	for l := range messages {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+	if len(locales) == 0 {
+		return ""
+	}
+	return messages[locales[0]]
+}
+
+// registerValidation will register the rule validation function,
+// converting the validator panic on restricted tags into an error.
+func registerValidation(
+	validate *validator.Validate,
+	rule Rule,
+) (e error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e = fmt.Errorf("%v", r)
+		}
+	}()
+	return validate.RegisterValidation(rule.Tag, rule.Func)
+}
@@ -0,0 +1,197 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+)
+
+func testRule() Rule {
+	return Rule{
+		Tag: "even",
+		Func: func(fl validator.FieldLevel) bool {
+			return fl.Field().Int()%2 == 0
+		},
+		Messages: map[string]string{
+			"en":          "{0} must be even",
+			"pt":          "{0} deve ser par",
+			"unsupported": "{0} unsupported",
+		},
+		Code: 1000,
+	}
+}
+
+func Test_RegisterRule(t *testing.T) {
+	universal, _ := NewUniversalTranslator("en", "pt")
+
+	t.Run("nil validate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := RegisterRule(nil, universal, NewMockParser(ctrl), testRule()); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil universal translator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := RegisterRule(validator.New(), nil, NewMockParser(ctrl), testRule()); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil parser", func(t *testing.T) {
+		if e := RegisterRule(validator.New(), universal, nil, testRule()); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("empty rule tag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule := testRule()
+		rule.Tag = ""
+
+		if e := RegisterRule(validator.New(), universal, NewMockParser(ctrl), rule); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrInvalidRule)
+		}
+	})
+
+	t.Run("nil rule function", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule := testRule()
+		rule.Func = nil
+
+		if e := RegisterRule(validator.New(), universal, NewMockParser(ctrl), rule); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrInvalidRule)
+		}
+	})
+
+	t.Run("reserved rule tag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule := testRule()
+		rule.Tag = "dive"
+
+		if e := RegisterRule(validator.New(), universal, NewMockParser(ctrl), rule); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidRule) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrInvalidRule)
+		}
+	})
+
+	t.Run("register rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		validate := validator.New()
+		parser := NewMockParser(ctrl)
+		parser.EXPECT().AddError("even", 1000).Times(1)
+		data := struct {
+			Field int `validate:"even"`
+		}{Field: 1}
+		expected := map[string]string{
+			"en": "Field must be even",
+			"pt": "Field deve ser par",
+		}
+
+		if e := RegisterRule(validate, universal, parser, testRule()); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if errs := validate.Struct(data); errs == nil {
+			t.Error("didn't validated the structure with the registered rule")
+		} else {
+			for locale, message := range expected {
+				translator, _ := universal.GetTranslator(locale)
+				if check := errs.(validator.ValidationErrors)[0].Translate(translator); check != message {
+					t.Errorf("translated to (%v) when expecting (%v)", check, message)
+				}
+			}
+		}
+	})
+
+	t.Run("register the fallback message of the locales without message", func(t *testing.T) {
+		universal, _ := NewUniversalTranslator("en", "es", "pt")
+		scenarios := []struct {
+			test     string
+			messages map[string]string
+			expected map[string]string
+		}{
+			{ // default locale message
+				test:     "default locale message",
+				messages: map[string]string{"en": "{0} must be even", "pt": "{0} deve ser par"},
+				expected: map[string]string{"en": "Field must be even", "es": "Field must be even", "pt": "Field deve ser par"},
+			},
+			{ // first locale message without default locale message
+				test:     "first locale message without default locale message",
+				messages: map[string]string{"pt": "{0} deve ser par", "unsupported": "{0} unsupported"},
+				expected: map[string]string{"en": "Field deve ser par", "es": "Field deve ser par", "pt": "Field deve ser par"},
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				rule := testRule()
+				rule.Code = 0
+				rule.Messages = s.messages
+				validate := validator.New()
+				data := struct {
+					Field int `validate:"even"`
+				}{Field: 1}
+
+				if e := RegisterRule(validate, universal, NewMockParser(ctrl), rule); e != nil {
+					t.Errorf("returned the unexpected error (%v)", e)
+				} else if errs := validate.Struct(data); errs == nil {
+					t.Error("didn't validated the structure with the registered rule")
+				} else {
+					for locale, message := range s.expected {
+						translator, _ := universal.GetTranslator(locale)
+						if check := errs.(validator.ValidationErrors)[0].Translate(translator); check != message {
+							t.Errorf("translated the (%v) locale to (%v) when expecting (%v)", locale, check, message)
+						}
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("register rule without error code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rule := testRule()
+		rule.Code = 0
+		rule.Messages = nil
+		validate := validator.New()
+		data := struct {
+			Field int `validate:"even"`
+		}{Field: 2}
+
+		if e := RegisterRule(validate, universal, NewMockParser(ctrl), rule); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if errs := validate.Struct(data); errs != nil {
+			t.Errorf("returned the unexpected validation error (%v)", errs)
+		}
+	})
+}
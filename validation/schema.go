@@ -0,0 +1,691 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/happyhippyhippo/slate/config"
+)
+
+// schemaAnnotations defines the JSON Schema keywords that don't affect
+// the document validation, and are accepted without any check.
+var schemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// SchemaError defines the information of a JSON Schema violation, where
+// the pointer is the JSON pointer of the violating value and the tag is
+// the parser error mapping name of the violated schema keyword.
+type SchemaError struct {
+	Pointer string
+	Tag     string
+	Limit   string
+}
+
+// Schema defines a JSON Schema document used to validate raw request
+// bodies. The supported keywords are the draft 7 type, enum, const,
+// required, properties, patternProperties, additionalProperties,
+// minProperties, maxProperties, items, minItems, maxItems, uniqueItems,
+// minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, multipleOf, allOf, anyOf, oneOf, not, definitions
+// and the local document $ref references, along with the annotation
+// keywords. Any other keyword is rejected when the schema is created,
+// so a schema is never partially enforced.
+type Schema struct {
+	root     interface{}
+	refs     map[string]interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+// NewSchema instantiates a new JSON Schema from the given JSON document.
+func NewSchema(
+	data []byte,
+) (*Schema, error) {
+	var document interface{}
+	if e := json.Unmarshal(data, &document); e != nil {
+		return nil, errInvalidSchema(e.Error())
+	}
+	return newSchema(document)
+}
+
+// LoadSchema instantiates a new JSON Schema from the JSON document
+// stored in the given file.
+func LoadSchema(
+	path string,
+) (*Schema, error) {
+	data, e := os.ReadFile(path)
+	if e != nil {
+		return nil, e
+	}
+	schema, e := NewSchema(data)
+	if e != nil {
+		return nil, errInvalidSchema(path, map[string]interface{}{"error": e})
+	}
+	return schema, nil
+}
+
+func newSchema(
+	document interface{},
+) (*Schema, error) {
+	s := &Schema{
+		root:     normalizeDocument(document),
+		refs:     map[string]interface{}{},
+		patterns: map[string]*regexp.Regexp{},
+	}
+	// check the schema keywords, compile the patterns and resolve
+	// the references, so the validation never fails on the schema
+	c := &schemaCompiler{
+		schema:  s,
+		nodes:   map[string]interface{}{},
+		refs:    map[string]string{},
+		inplace: map[string][]string{},
+	}
+	if e := c.compile(s.root, ""); e != nil {
+		return nil, e
+	}
+	if e := c.link(); e != nil {
+		return nil, e
+	}
+	return s, nil
+}
+
+// Validate will check the given raw JSON document against the schema,
+// returning the list of the founded violations. An error is returned if
+// the document is not a valid JSON document.
+func (s *Schema) Validate(
+	data []byte,
+) ([]SchemaError, error) {
+	var value interface{}
+	if e := json.Unmarshal(data, &value); e != nil {
+		return nil, e
+	}
+	return s.validate(s.root, value, ""), nil
+}
+
+func (s *Schema) validate(
+	node interface{},
+	value interface{},
+	pointer string,
+) []SchemaError {
+	// check the boolean schemas
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		if allowed, _ := node.(bool); !allowed {
+			return []SchemaError{{Pointer: pointer, Tag: "not"}}
+		}
+		return nil
+	}
+	// follow the schema reference
+	if ref, ok := schema["$ref"].(string); ok {
+		return s.validate(s.refs[ref], value, pointer)
+	}
+	// check the value type, discarding the remaining checks on mismatch
+	if types := schemaTypes(schema["type"]); len(types) != 0 {
+		matched := false
+		for _, t := range types {
+			matched = matched || matchesType(t, value)
+		}
+		if !matched {
+			return []SchemaError{{Pointer: pointer, Tag: "type", Limit: strings.Join(types, " ")}}
+		}
+	}
+	var errs []SchemaError
+	// check the enumerated and constant values
+	if options, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, option := range options {
+			found = found || reflect.DeepEqual(option, value)
+		}
+		if !found {
+			errs = append(errs, SchemaError{Pointer: pointer, Tag: "oneof", Limit: joinValues(options)})
+		}
+	}
+	if expected, ok := schema["const"]; ok && !reflect.DeepEqual(expected, value) {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "eq", Limit: joinValues([]interface{}{expected})})
+	}
+	// check the value type specific keywords
+	switch v := value.(type) {
+	case map[string]interface{}:
+		errs = append(errs, s.validateObject(schema, v, pointer)...)
+	case []interface{}:
+		errs = append(errs, s.validateArray(schema, v, pointer)...)
+	case string:
+		errs = append(errs, s.validateString(schema, v, pointer)...)
+	case float64:
+		errs = append(errs, validateNumber(schema, v, pointer)...)
+	}
+	// check the schema composition keywords
+	return append(errs, s.validateComposition(schema, value, pointer)...)
+}
+
+func (s *Schema) validateObject(
+	schema map[string]interface{},
+	value map[string]interface{},
+	pointer string,
+) []SchemaError {
+	var errs []SchemaError
+	// check the required properties
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if _, found := value[name.(string)]; !found {
+				errs = append(errs, SchemaError{Pointer: pointer + "/" + escapePointer(name.(string)), Tag: "required"})
+			}
+		}
+	}
+	// check the number of properties
+	errs = append(errs, checkSize(schema, "minProperties", "maxProperties", len(value), pointer)...)
+	// check the properties values, in a predictable order
+	properties, _ := schema["properties"].(map[string]interface{})
+	patterns, _ := schema["patternProperties"].(map[string]interface{})
+	for _, name := range sortedKeys(value) {
+		path := pointer + "/" + escapePointer(name)
+		matched := false
+		if property, ok := properties[name]; ok {
+			matched = true
+			errs = append(errs, s.validate(property, value[name], path)...)
+		}
+		for _, pattern := range sortedKeys(patterns) {
+			if s.patterns[pattern].MatchString(name) {
+				matched = true
+				errs = append(errs, s.validate(patterns[pattern], value[name], path)...)
+			}
+		}
+		// check the properties not defined in the schema
+		if additional, ok := schema["additionalProperties"]; ok && !matched {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				errs = append(errs, SchemaError{Pointer: path, Tag: "additional_properties"})
+				continue
+			}
+			errs = append(errs, s.validate(additional, value[name], path)...)
+		}
+	}
+	return errs
+}
+
+func (s *Schema) validateArray(
+	schema map[string]interface{},
+	value []interface{},
+	pointer string,
+) []SchemaError {
+	// check the number of items
+	errs := checkSize(schema, "minItems", "maxItems", len(value), pointer)
+	// check the items uniqueness
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+	loop:
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if reflect.DeepEqual(value[i], value[j]) {
+					errs = append(errs, SchemaError{Pointer: pointer, Tag: "unique"})
+					break loop
+				}
+			}
+		}
+	}
+	// check the items values against the items schema, or against the
+	// positional schemas of a tuple items definition
+	items, ok := schema["items"]
+	if !ok {
+		return errs
+	}
+	for i, item := range value {
+		node := items
+		if tuple, ok := items.([]interface{}); ok {
+			if i >= len(tuple) {
+				break
+			}
+			node = tuple[i]
+		}
+		errs = append(errs, s.validate(node, item, pointer+"/"+strconv.Itoa(i))...)
+	}
+	return errs
+}
+
+func (s *Schema) validateString(
+	schema map[string]interface{},
+	value string,
+	pointer string,
+) []SchemaError {
+	// check the string length in characters
+	errs := checkSize(schema, "minLength", "maxLength", len([]rune(value)), pointer)
+	// check the string pattern
+	if pattern, ok := schema["pattern"].(string); ok && !s.patterns[pattern].MatchString(value) {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "pattern", Limit: pattern})
+	}
+	return errs
+}
+
+func (s *Schema) validateComposition(
+	schema map[string]interface{},
+	value interface{},
+	pointer string,
+) []SchemaError {
+	var errs []SchemaError
+	// all the sub-schemas violations are reported
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, node := range all {
+			errs = append(errs, s.validate(node, value, pointer)...)
+		}
+	}
+	// count the matching alternative sub-schemas
+	matches := func(nodes []interface{}) int {
+		count := 0
+		for _, node := range nodes {
+			if len(s.validate(node, value, pointer)) == 0 {
+				count++
+			}
+		}
+		return count
+	}
+	if alternatives, ok := schema["anyOf"].([]interface{}); ok && matches(alternatives) == 0 {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "any_of"})
+	}
+	if alternatives, ok := schema["oneOf"].([]interface{}); ok && matches(alternatives) != 1 {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "one_of"})
+	}
+	if not, ok := schema["not"]; ok && matches([]interface{}{not}) != 0 {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "not"})
+	}
+	return errs
+}
+
+// schemaCompiler checks a schema document, compiling its patterns and
+// resolving its references. The nodes map stores the document schemas
+// by JSON pointer, and the inplace map stores, for each schema, the
+// schemas applied to the same value, used to detect the reference
+// cycles that would never end the validation.
+type schemaCompiler struct {
+	schema  *Schema
+	nodes   map[string]interface{}
+	refs    map[string]string
+	inplace map[string][]string
+}
+
+func (c *schemaCompiler) compile(
+	node interface{},
+	pointer string,
+) error {
+	// a schema must be an object or a boolean schema
+	var schema map[string]interface{}
+	switch n := node.(type) {
+	case bool:
+		c.nodes[pointer] = n
+		return nil
+	case map[string]interface{}:
+		c.nodes[pointer] = n
+		schema = n
+	default:
+		return errInvalidSchema(fmt.Sprintf("%v", node), map[string]interface{}{"pointer": pointer})
+	}
+	// check all the keywords, in a predictable order
+	for _, keyword := range sortedKeys(schema) {
+		if e := c.keyword(schema, keyword, pointer); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *schemaCompiler) keyword(
+	schema map[string]interface{},
+	keyword string,
+	pointer string,
+) error {
+	value := schema[keyword]
+	path := pointer + "/" + escapePointer(keyword)
+	invalid := errInvalidSchema(keyword, map[string]interface{}{"pointer": path})
+	switch keyword {
+	case "$ref":
+		// the draft 7 reference sibling keywords are ignored,
+		// so only the annotations and definitions are accepted
+		for _, sibling := range sortedKeys(schema) {
+			if sibling != keyword && sibling != "definitions" && !schemaAnnotations[sibling] {
+				return errInvalidSchema(sibling, map[string]interface{}{"pointer": pointer + "/" + escapePointer(sibling)})
+			}
+		}
+		ref, ok := value.(string)
+		if !ok {
+			return invalid
+		}
+		c.refs[pointer] = ref
+	case "type":
+		types := schemaTypes(value)
+		if list, ok := value.([]interface{}); len(types) == 0 || (ok && len(types) != len(list)) {
+			return invalid
+		}
+		for _, t := range types {
+			switch t {
+			case "null", "boolean", "object", "array", "number", "integer", "string":
+			default:
+				return invalid
+			}
+		}
+	case "enum":
+		if _, ok := value.([]interface{}); !ok {
+			return invalid
+		}
+	case "required":
+		list, ok := value.([]interface{})
+		if !ok {
+			return invalid
+		}
+		for _, name := range list {
+			if _, ok := name.(string); !ok {
+				return invalid
+			}
+		}
+	case "minProperties", "maxProperties", "minItems", "maxItems", "minLength", "maxLength":
+		if limit, ok := value.(float64); !ok || limit < 0 || limit != math.Trunc(limit) {
+			return invalid
+		}
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+		if _, ok := value.(float64); !ok {
+			return invalid
+		}
+	case "multipleOf":
+		if limit, ok := value.(float64); !ok || limit <= 0 {
+			return invalid
+		}
+	case "uniqueItems":
+		if _, ok := value.(bool); !ok {
+			return invalid
+		}
+	case "pattern":
+		pattern, ok := value.(string)
+		if !ok {
+			return invalid
+		}
+		return c.pattern(pattern, path)
+	case "properties", "patternProperties", "definitions":
+		nodes, ok := value.(map[string]interface{})
+		if !ok {
+			return invalid
+		}
+		for _, name := range sortedKeys(nodes) {
+			if keyword == "patternProperties" {
+				if e := c.pattern(name, path); e != nil {
+					return e
+				}
+			}
+			if e := c.compile(nodes[name], path+"/"+escapePointer(name)); e != nil {
+				return e
+			}
+		}
+	case "items":
+		if list, ok := value.([]interface{}); ok {
+			return c.compileList(list, path)
+		}
+		return c.compile(value, path)
+	case "additionalProperties":
+		return c.compile(value, path)
+	case "not":
+		c.inplace[pointer] = append(c.inplace[pointer], path)
+		return c.compile(value, path)
+	case "allOf", "anyOf", "oneOf":
+		list, ok := value.([]interface{})
+		if !ok || len(list) == 0 {
+			return invalid
+		}
+		for i := range list {
+			c.inplace[pointer] = append(c.inplace[pointer], path+"/"+strconv.Itoa(i))
+		}
+		return c.compileList(list, path)
+	case "const":
+	default:
+		// reject the unsupported keywords instead of silently
+		// accepting the documents that they would reject
+		if !schemaAnnotations[keyword] {
+			return errInvalidSchema(keyword, map[string]interface{}{"pointer": path, "error": "unsupported keyword"})
+		}
+	}
+	return nil
+}
+
+func (c *schemaCompiler) compileList(
+	list []interface{},
+	pointer string,
+) error {
+	for i, node := range list {
+		if e := c.compile(node, pointer+"/"+strconv.Itoa(i)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *schemaCompiler) pattern(
+	pattern string,
+	pointer string,
+) error {
+	re, e := regexp.Compile(pattern)
+	if e != nil {
+		return errInvalidSchema(pattern, map[string]interface{}{"pointer": pointer, "error": e})
+	}
+	c.schema.patterns[pattern] = re
+	return nil
+}
+
+func (c *schemaCompiler) link() error {
+	// resolve the references to the document schemas
+	for _, pointer := range sortedKeys(c.refs) {
+		ref := c.refs[pointer]
+		target, e := resolveRef(ref)
+		if e != nil {
+			return e
+		}
+		node, ok := c.nodes[target]
+		if !ok {
+			return errInvalidSchema(ref, map[string]interface{}{"pointer": pointer})
+		}
+		c.schema.refs[ref] = node
+		c.inplace[pointer] = append(c.inplace[pointer], target)
+	}
+	// reject the reference cycles that don't descend into the value
+	const visiting, visited = 1, 2
+	state := map[string]int{}
+	var visit func(pointer string) error
+	visit = func(pointer string) error {
+		switch state[pointer] {
+		case visiting:
+			return errInvalidSchema("$ref", map[string]interface{}{"pointer": pointer, "error": "reference cycle"})
+		case visited:
+			return nil
+		}
+		state[pointer] = visiting
+		for _, next := range c.inplace[pointer] {
+			if e := visit(next); e != nil {
+				return e
+			}
+		}
+		state[pointer] = visited
+		return nil
+	}
+	for _, pointer := range sortedKeys(c.inplace) {
+		if e := visit(pointer); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func resolveRef(
+	ref string,
+) (string, error) {
+	// only local document references are supported
+	if !strings.HasPrefix(ref, "#") {
+		return "", errInvalidSchema(ref, map[string]interface{}{"error": "non local reference"})
+	}
+	pointer, e := url.PathUnescape(strings.TrimPrefix(ref, "#"))
+	if e != nil {
+		return "", errInvalidSchema(ref, map[string]interface{}{"error": e})
+	}
+	return pointer, nil
+}
+
+func validateNumber(
+	schema map[string]interface{},
+	value float64,
+	pointer string,
+) []SchemaError {
+	var errs []SchemaError
+	checks := []struct {
+		keyword string
+		tag     string
+		failed  func(limit float64) bool
+	}{
+		{keyword: "minimum", tag: "gte", failed: func(limit float64) bool { return value < limit }},
+		{keyword: "maximum", tag: "lte", failed: func(limit float64) bool { return value > limit }},
+		{keyword: "exclusiveMinimum", tag: "gt", failed: func(limit float64) bool { return value <= limit }},
+		{keyword: "exclusiveMaximum", tag: "lt", failed: func(limit float64) bool { return value >= limit }},
+		{keyword: "multipleOf", tag: "multiple_of", failed: func(limit float64) bool {
+			quotient := value / limit
+			return limit > 0 && math.Abs(quotient-math.Round(quotient)) > 1e-9
+		}},
+	}
+	for _, check := range checks {
+		if limit, ok := schema[check.keyword].(float64); ok && check.failed(limit) {
+			errs = append(errs, SchemaError{Pointer: pointer, Tag: check.tag, Limit: formatLimit(limit)})
+		}
+	}
+	return errs
+}
+
+func checkSize(
+	schema map[string]interface{},
+	minKeyword string,
+	maxKeyword string,
+	size int,
+	pointer string,
+) []SchemaError {
+	var errs []SchemaError
+	if limit, ok := schema[minKeyword].(float64); ok && float64(size) < limit {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "min", Limit: formatLimit(limit)})
+	}
+	if limit, ok := schema[maxKeyword].(float64); ok && float64(size) > limit {
+		errs = append(errs, SchemaError{Pointer: pointer, Tag: "max", Limit: formatLimit(limit)})
+	}
+	return errs
+}
+
+func schemaTypes(
+	value interface{},
+) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var types []string
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func matchesType(
+	t string,
+	value interface{},
+) bool {
+	switch v := value.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case string:
+		return t == "string"
+	case float64:
+		return t == "number" || (t == "integer" && v == math.Trunc(v))
+	case []interface{}:
+		return t == "array"
+	case map[string]interface{}:
+		return t == "object"
+	}
+	return false
+}
+
+func sortedKeys[T any](
+	m map[string]T,
+) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinValues(
+	values []interface{},
+) string {
+	var parts []string
+	for _, v := range values {
+		encoded, _ := json.Marshal(v)
+		parts = append(parts, string(encoded))
+	}
+	return strings.Join(parts, " ")
+}
+
+func escapePointer(
+	token string,
+) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// normalizeDocument converts a configuration or decoded document into
+// the JSON decoded representation, where all the objects are string
+// keyed maps and all the numbers are float64 values.
+func normalizeDocument(
+	document interface{},
+) interface{} {
+	switch d := document.(type) {
+	case config.Config:
+		return normalizeDocument(map[interface{}]interface{}(d))
+	case map[interface{}]interface{}:
+		m := map[string]interface{}{}
+		for k, v := range d {
+			m[fmt.Sprintf("%v", k)] = normalizeDocument(v)
+		}
+		return m
+	case map[string]interface{}:
+		m := map[string]interface{}{}
+		for k, v := range d {
+			m[k] = normalizeDocument(v)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(d))
+		for i, v := range d {
+			l[i] = normalizeDocument(v)
+		}
+		return l
+	case int:
+		return float64(d)
+	case int64:
+		return float64(d)
+	case uint:
+		return float64(d)
+	case uint64:
+		return float64(d)
+	case float32:
+		return float64(d)
+	}
+	return document
+}
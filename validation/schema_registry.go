@@ -0,0 +1,180 @@
+package validation
+
+import (
+	"sync"
+
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+// ISchemaRegistry defines the interface of a JSON Schema registry
+// instance, used to store the request body schemas by endpoint id.
+type ISchemaRegistry interface {
+	Has(id string) bool
+	Get(id string) (*Schema, error)
+	Add(id string, schema *Schema) error
+	Remove(id string)
+}
+
+type schemaRegistry struct {
+	mutex   sync.RWMutex
+	schemas map[string]*Schema
+}
+
+var _ ISchemaRegistry = &schemaRegistry{}
+
+// NewSchemaRegistry will instantiate a new JSON Schema registry without
+// any registered schema.
+func NewSchemaRegistry() ISchemaRegistry {
+	return &schemaRegistry{
+		schemas: map[string]*Schema{},
+	}
+}
+
+// Has will check if there is a schema registered for the given
+// endpoint id.
+func (r *schemaRegistry) Has(
+	id string,
+) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	_, ok := r.schemas[id]
+	return ok
+}
+
+// Get will retrieve the schema registered for the given endpoint id.
+func (r *schemaRegistry) Get(
+	id string,
+) (*Schema, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	schema, ok := r.schemas[id]
+	if !ok {
+		return nil, errSchemaNotFound(id)
+	}
+	return schema, nil
+}
+
+// Add will register the schema of the given endpoint id, replacing any
+// previously registered schema.
+func (r *schemaRegistry) Add(
+	id string,
+	schema *Schema,
+) error {
+	// check the schema argument reference
+	if schema == nil {
+		return errNilPointer("schema")
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.schemas[id] = schema
+	return nil
+}
+
+// Remove will discard the schema registered for the given endpoint id.
+func (r *schemaRegistry) Remove(
+	id string,
+) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.schemas, id)
+}
+
+// ObserveSchemas will load the endpoint JSON Schemas defined in the
+// configuration into the registry, and register the config observer
+// that keeps the registry updated with the configuration changes. Each
+// configuration entry maps an endpoint id to an inline schema document
+// or to the path of a schema document file. An invalid configuration
+// change is discarded and logged, keeping the last valid loaded schemas.
+func ObserveSchemas(
+	registry ISchemaRegistry,
+	cfg config.IManager,
+	logger log.ILog,
+) error {
+	return observeSchemas(registry, cfg, logger, SchemasConfigPath)
+}
+
+func observeSchemas(
+	registry ISchemaRegistry,
+	cfg config.IManager,
+	logger log.ILog,
+	path string,
+) error {
+	// check registry argument reference
+	if registry == nil {
+		return errNilPointer("registry")
+	}
+	// check config argument reference
+	if cfg == nil {
+		return errNilPointer("cfg")
+	}
+	// check logger argument reference
+	if logger == nil {
+		return errNilPointer("logger")
+	}
+	// validate log level
+	logLevel, ok := log.LevelMap[LogLevel]
+	if !ok {
+		logLevel = log.WARNING
+	}
+	// load the initial configured schemas
+	schemas, e := loadSchemas(cfg, path)
+	if e != nil {
+		return e
+	}
+	for id, schema := range schemas {
+		_ = registry.Add(id, schema)
+	}
+	// add a config observer that replaces the configured schemas
+	var mutex sync.Mutex
+	_ = cfg.AddObserver(path, func(old interface{}, new interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		reloaded, e := loadSchemas(cfg, path)
+		if e != nil {
+			_ = logger.Signal(LogChannel, logLevel, LogSchemasErrorMessage, log.Context{"error": e, "path": path})
+			return
+		}
+		for id := range schemas {
+			if _, ok := reloaded[id]; !ok {
+				registry.Remove(id)
+			}
+		}
+		for id, schema := range reloaded {
+			_ = registry.Add(id, schema)
+		}
+		schemas = reloaded
+	})
+	return nil
+}
+
+func loadSchemas(
+	cfg config.IConfig,
+	path string,
+) (map[string]*Schema, error) {
+	// retrieve the schemas configuration block
+	block, e := cfg.Get(path, config.Config{})
+	if e != nil {
+		return nil, e
+	}
+	entries, ok := normalizeDocument(block).(map[string]interface{})
+	if !ok {
+		return nil, errConversion(block, "config.Config")
+	}
+	// load all the block entries, discarding the whole
+	// block if any entry is not a valid schema
+	schemas := map[string]*Schema{}
+	for id, entry := range entries {
+		var schema *Schema
+		if path, ok := entry.(string); ok {
+			schema, e = LoadSchema(path)
+		} else {
+			schema, e = newSchema(entry)
+		}
+		if e != nil {
+			return nil, e
+		}
+		schemas[id] = schema
+	}
+	return schemas, nil
+}
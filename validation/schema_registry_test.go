@@ -0,0 +1,205 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate/config"
+	"github.com/happyhippyhippo/slate/log"
+)
+
+func Test_SchemaRegistry(t *testing.T) {
+	t.Run("nil schema", func(t *testing.T) {
+		if e := NewSchemaRegistry().Add("id", nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("schema not found", func(t *testing.T) {
+		sut := NewSchemaRegistry()
+
+		if sut.Has("id") {
+			t.Error("unexpectedly found the schema")
+		} else if _, e := sut.Get("id"); !errors.Is(e, ErrSchemaNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrSchemaNotFound)
+		}
+	})
+
+	t.Run("add, retrieve and remove a schema", func(t *testing.T) {
+		schema, _ := NewSchema([]byte(`{}`))
+		sut := NewSchemaRegistry()
+		_ = sut.Add("id", schema)
+
+		if check, e := sut.Get("id"); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if check != schema {
+			t.Errorf("returned the (%v) schema", check)
+		} else if sut.Remove("id"); sut.Has("id") {
+			t.Error("didn't removed the schema")
+		}
+	})
+}
+
+func Test_ObserveSchemas(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "schema.json")
+	_ = os.WriteFile(file, []byte(`{"type":"object"}`), 0o644)
+
+	t.Run("nil registry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveSchemas(nil, NewMockConfigManager(ctrl), NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveSchemas(NewSchemaRegistry(), nil, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if e := ObserveSchemas(NewSchemaRegistry(), NewMockConfigManager(ctrl), nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("error retrieving the schemas configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error message")
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(nil, expected).Times(1)
+
+		if e := ObserveSchemas(NewSchemaRegistry(), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if e.Error() != expected.Error() {
+			t.Errorf("returned (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("invalid schemas configuration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return("string", nil).Times(1)
+
+		if e := ObserveSchemas(NewSchemaRegistry(), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrConversion) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, slate.ErrConversion)
+		}
+	})
+
+	t.Run("invalid schema file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"id": filepath.Join(dir, "missing.json")}, nil).Times(1)
+
+		if e := ObserveSchemas(NewSchemaRegistry(), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, os.ErrNotExist) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, os.ErrNotExist)
+		}
+	})
+
+	t.Run("unsupported schema keyword", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"id": config.Config{"type": "string", "format": "email"}}, nil).Times(1)
+
+		if e := ObserveSchemas(NewSchemaRegistry(), cfg, NewMockLog(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("load the configured schemas", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cfg := NewMockConfigManager(ctrl)
+		cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{
+			"users.create": config.Config{"type": "object", "required": []interface{}{"name"}},
+			"users.update": file,
+		}, nil).Times(1)
+		cfg.EXPECT().AddObserver(SchemasConfigPath, gomock.Any()).Return(nil).Times(1)
+		sut := NewSchemaRegistry()
+
+		if e := ObserveSchemas(sut, cfg, NewMockLog(ctrl)); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if !sut.Has("users.create") || !sut.Has("users.update") {
+			t.Error("didn't loaded the configured schemas")
+		} else if schema, _ := sut.Get("users.create"); schema != nil {
+			if errs, _ := schema.Validate([]byte(`{}`)); len(errs) != 1 {
+				t.Errorf("returned the (%v) errors of the inline schema", errs)
+			}
+		}
+	})
+
+	t.Run("reload the schemas on configuration changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var observer config.IObserver
+		cfg := NewMockConfigManager(ctrl)
+		gomock.InOrder(
+			cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"a": config.Config{}, "b": config.Config{}}, nil),
+			cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"a": 1}, nil),
+			cfg.EXPECT().Get(SchemasConfigPath, config.Config{}).Return(config.Config{"b": config.Config{}, "c": true}, nil),
+		)
+		cfg.EXPECT().AddObserver(SchemasConfigPath, gomock.Any()).DoAndReturn(func(_ string, o config.IObserver) error {
+			observer = o
+			return nil
+		}).Times(1)
+		logger := NewMockLog(ctrl)
+		logger.EXPECT().Signal(LogChannel, log.WARNING, LogSchemasErrorMessage, gomock.Any()).Return(nil).Times(1)
+		sut := NewSchemaRegistry()
+		manual, _ := NewSchema([]byte(`{}`))
+		_ = sut.Add("manual", manual)
+
+		_ = ObserveSchemas(sut, cfg, logger)
+		observer(nil, nil)
+		if !sut.Has("a") || !sut.Has("b") {
+			t.Error("discarded the schemas after an invalid change")
+		}
+
+		observer(nil, nil)
+		switch {
+		case sut.Has("a"):
+			t.Error("didn't removed the unconfigured schema")
+		case !sut.Has("b") || !sut.Has("c"):
+			t.Error("didn't loaded the reconfigured schemas")
+		case !sut.Has("manual"):
+			t.Error("removed the manually added schema")
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/happyhippyhippo/slate/config"
+)
+
+func Test_NewSchema(t *testing.T) {
+	t.Run("invalid JSON document", func(t *testing.T) {
+		if _, e := NewSchema([]byte("{")); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("non object schema", func(t *testing.T) {
+		if _, e := NewSchema([]byte("[]")); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		scenarios := []struct {
+			test   string
+			schema string
+		}{
+			{ // invalid sub-schema
+				test:   "invalid sub-schema",
+				schema: `{"properties":{"name":"string"}}`,
+			},
+			{ // unsupported keyword
+				test:   "unsupported keyword",
+				schema: `{"properties":{"email":{"type":"string","format":"email"}}}`,
+			},
+			{ // unsupported conditional keywords
+				test:   "unsupported conditional keywords",
+				schema: `{"if":{"type":"string"},"then":{"minLength":1}}`,
+			},
+			{ // unsupported array keyword
+				test:   "unsupported array keyword",
+				schema: `{"items":[true],"additionalItems":false}`,
+			},
+			{ // unsupported object keyword
+				test:   "unsupported object keyword",
+				schema: `{"propertyNames":{"maxLength":3}}`,
+			},
+			{ // invalid type name
+				test:   "invalid type name",
+				schema: `{"type":["string","text"]}`,
+			},
+			{ // invalid required list
+				test:   "invalid required list",
+				schema: `{"required":["name",1]}`,
+			},
+			{ // invalid size limit
+				test:   "invalid size limit",
+				schema: `{"minLength":-1}`,
+			},
+			{ // invalid number limit
+				test:   "invalid number limit",
+				schema: `{"maximum":"10"}`,
+			},
+			{ // invalid multiple
+				test:   "invalid multiple",
+				schema: `{"multipleOf":0}`,
+			},
+			{ // empty composition
+				test:   "empty composition",
+				schema: `{"anyOf":[]}`,
+			},
+			{ // invalid pattern
+				test:   "invalid pattern",
+				schema: `{"pattern":"("}`,
+			},
+			{ // invalid pattern property
+				test:   "invalid pattern property",
+				schema: `{"patternProperties":{"(":true}}`,
+			},
+			{ // reference sibling keyword
+				test:   "reference sibling keyword",
+				schema: `{"definitions":{"name":{"type":"string"}},"$ref":"#/definitions/name","minLength":1}`,
+			},
+			{ // remote reference
+				test:   "remote reference",
+				schema: `{"$ref":"http://example.com/schema.json"}`,
+			},
+			{ // unresolved reference
+				test:   "unresolved reference",
+				schema: `{"$ref":"#/definitions/missing"}`,
+			},
+			{ // invalid reference index
+				test:   "invalid reference index",
+				schema: `{"allOf":[{"$ref":"#/allOf/5"}]}`,
+			},
+			{ // non schema reference
+				test:   "non schema reference",
+				schema: `{"enum":[{"type":"string"}],"properties":{"name":{"$ref":"#/enum/0"}}}`,
+			},
+			{ // recursive reference
+				test:   "recursive reference",
+				schema: `{"$ref":"#"}`,
+			},
+			{ // recursive composition reference
+				test:   "recursive composition reference",
+				schema: `{"definitions":{"a":{"anyOf":[{"$ref":"#/definitions/b"}]},"b":{"not":{"$ref":"#/definitions/a"}}},"$ref":"#/definitions/a"}`,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				if _, e := NewSchema([]byte(s.schema)); !errors.Is(e, ErrInvalidSchema) {
+					t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+				}
+			})
+		}
+	})
+
+	t.Run("boolean schema", func(t *testing.T) {
+		if sut, e := NewSchema([]byte("true")); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if sut == nil {
+			t.Error("didn't returned a valid reference")
+		}
+	})
+}
+
+func Test_LoadSchema(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	invalid := filepath.Join(dir, "invalid.json")
+	_ = os.WriteFile(valid, []byte(`{"type":"object"}`), 0o644)
+	_ = os.WriteFile(invalid, []byte(`"string"`), 0o644)
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, e := LoadSchema(filepath.Join(dir, "missing.json")); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, os.ErrNotExist) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, os.ErrNotExist)
+		}
+	})
+
+	t.Run("invalid schema file", func(t *testing.T) {
+		if _, e := LoadSchema(invalid); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, ErrInvalidSchema) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrInvalidSchema)
+		}
+	})
+
+	t.Run("load the schema file", func(t *testing.T) {
+		sut, e := LoadSchema(valid)
+		if e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if errs, _ := sut.Validate([]byte("[]")); len(errs) != 1 {
+			t.Errorf("returned the (%v) errors", errs)
+		}
+	})
+}
+
+func Test_Schema_Validate(t *testing.T) {
+	t.Run("invalid JSON document", func(t *testing.T) {
+		sut, _ := NewSchema([]byte(`{}`))
+
+		var syntax *json.SyntaxError
+		if _, e := sut.Validate([]byte("{")); !errors.As(e, &syntax) {
+			t.Errorf("returned the (%v) error when expecting a syntax error", e)
+		}
+	})
+
+	t.Run("validate the document", func(t *testing.T) {
+		scenarios := []struct {
+			test     string
+			schema   string
+			data     string
+			expected []SchemaError
+		}{
+			{ // false schema
+				test:     "false schema",
+				schema:   `false`,
+				data:     `{}`,
+				expected: []SchemaError{{Pointer: "", Tag: "not"}},
+			},
+			{ // type mismatch
+				test:     "type mismatch",
+				schema:   `{"type":["object","null"],"required":["name"]}`,
+				data:     `[]`,
+				expected: []SchemaError{{Pointer: "", Tag: "type", Limit: "object null"}},
+			},
+			{ // integer type
+				test:     "integer type",
+				schema:   `{"type":"array","items":{"type":"integer"}}`,
+				data:     `[1, 1.5, true, "1", null]`,
+				expected: []SchemaError{{Pointer: "/1", Tag: "type", Limit: "integer"}, {Pointer: "/2", Tag: "type", Limit: "integer"}, {Pointer: "/3", Tag: "type", Limit: "integer"}, {Pointer: "/4", Tag: "type", Limit: "integer"}},
+			},
+			{ // enum and const
+				test:     "enum and const",
+				schema:   `{"properties":{"a":{"enum":["x",1]},"b":{"const":{"k":true}}}}`,
+				data:     `{"a":"y","b":{"k":false}}`,
+				expected: []SchemaError{{Pointer: "/a", Tag: "oneof", Limit: `"x" 1`}, {Pointer: "/b", Tag: "eq", Limit: `{"k":true}`}},
+			},
+			{ // object keywords
+				test:   "object keywords",
+				schema: `{"required":["name","a/b"],"minProperties":3,"properties":{"age":{"type":"integer"}},"patternProperties":{"^x-":{"type":"string"}},"additionalProperties":false}`,
+				data:   `{"age":"1","x-tag":1,"other":true}`,
+				expected: []SchemaError{
+					{Pointer: "/name", Tag: "required"},
+					{Pointer: "/a~1b", Tag: "required"},
+					{Pointer: "/age", Tag: "type", Limit: "integer"},
+					{Pointer: "/other", Tag: "additional_properties"},
+					{Pointer: "/x-tag", Tag: "type", Limit: "string"},
+				},
+			},
+			{ // additional properties schema
+				test:     "additional properties schema",
+				schema:   `{"maxProperties":1,"additionalProperties":{"type":"string"}}`,
+				data:     `{"a":"x","b":2}`,
+				expected: []SchemaError{{Pointer: "", Tag: "max", Limit: "1"}, {Pointer: "/b", Tag: "type", Limit: "string"}},
+			},
+			{ // array keywords
+				test:     "array keywords",
+				schema:   `{"minItems":4,"uniqueItems":true,"items":{"maximum":2}}`,
+				data:     `[1, 3, 1]`,
+				expected: []SchemaError{{Pointer: "", Tag: "min", Limit: "4"}, {Pointer: "", Tag: "unique"}, {Pointer: "/1", Tag: "lte", Limit: "2"}},
+			},
+			{ // tuple items
+				test:     "tuple items",
+				schema:   `{"maxItems":2,"items":[{"type":"string"},{"type":"number"}]}`,
+				data:     `["a", "b", "c"]`,
+				expected: []SchemaError{{Pointer: "", Tag: "max", Limit: "2"}, {Pointer: "/1", Tag: "type", Limit: "number"}},
+			},
+			{ // string keywords
+				test:     "string keywords",
+				schema:   `{"items":{"minLength":3,"maxLength":4,"pattern":"^[a-z]+$"}}`,
+				data:     `["ab", "abcde", "ABC", "ção"]`,
+				expected: []SchemaError{{Pointer: "/0", Tag: "min", Limit: "3"}, {Pointer: "/1", Tag: "max", Limit: "4"}, {Pointer: "/2", Tag: "pattern", Limit: "^[a-z]+$"}, {Pointer: "/3", Tag: "pattern", Limit: "^[a-z]+$"}},
+			},
+			{ // number keywords
+				test:     "number keywords",
+				schema:   `{"items":{"minimum":1,"exclusiveMaximum":10,"multipleOf":0.5}}`,
+				data:     `[0.5, 10, 2.5, 3.3]`,
+				expected: []SchemaError{{Pointer: "/0", Tag: "gte", Limit: "1"}, {Pointer: "/1", Tag: "lt", Limit: "10"}, {Pointer: "/3", Tag: "multiple_of", Limit: "0.5"}},
+			},
+			{ // exclusive minimum
+				test:     "exclusive minimum",
+				schema:   `{"exclusiveMinimum":1}`,
+				data:     `1`,
+				expected: []SchemaError{{Pointer: "", Tag: "gt", Limit: "1"}},
+			},
+			{ // composition keywords
+				test:   "composition keywords",
+				schema: `{"allOf":[{"minimum":5}],"anyOf":[{"type":"string"},{"maximum":1}],"oneOf":[{"type":"number"},{"type":"integer"}],"not":{"type":"integer"}}`,
+				data:   `3`,
+				expected: []SchemaError{
+					{Pointer: "", Tag: "gte", Limit: "5"},
+					{Pointer: "", Tag: "any_of"},
+					{Pointer: "", Tag: "one_of"},
+					{Pointer: "", Tag: "not"},
+				},
+			},
+			{ // satisfied composition keywords
+				test:     "satisfied composition keywords",
+				schema:   `{"anyOf":[{"type":"string"},{"maximum":1}],"oneOf":[{"type":"string"},{"type":"integer"}],"not":{"type":"string"}}`,
+				data:     `1`,
+				expected: nil,
+			},
+			{ // local references
+				test:     "local references",
+				schema:   `{"definitions":{"a/b":{"type":"string"},"list":{"type":"array","items":{"$ref":"#/definitions/a~1b"}}},"properties":{"tags":{"$ref":"#/definitions/list"},"first":{"$ref":"#/definitions/list/items"}}}`,
+				data:     `{"tags":["a",1],"first":2}`,
+				expected: []SchemaError{{Pointer: "/first", Tag: "type", Limit: "string"}, {Pointer: "/tags/1", Tag: "type", Limit: "string"}},
+			},
+			{ // recursive structure reference
+				test:     "recursive structure reference",
+				schema:   `{"$comment":"tree","definitions":{"node":{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/node"}}}}},"$ref":"#/definitions/node"}`,
+				data:     `{"children":[{"children":[]},{"children":[{"children":1}]}]}`,
+				expected: []SchemaError{{Pointer: "/children/1/children/0/children", Tag: "type", Limit: "array"}},
+			},
+			{ // valid document
+				test:     "valid document",
+				schema:   `{"type":"object","required":["name"],"properties":{"name":{"type":"string","minLength":1}}}`,
+				data:     `{"name":"john","age":30}`,
+				expected: nil,
+			},
+		}
+
+		for _, s := range scenarios {
+			t.Run(s.test, func(t *testing.T) {
+				sut, e := NewSchema([]byte(s.schema))
+				if e != nil {
+					t.Fatalf("returned the unexpected schema error (%v)", e)
+				}
+
+				if errs, e := sut.Validate([]byte(s.data)); e != nil {
+					t.Errorf("returned the unexpected error (%v)", e)
+				} else if !reflect.DeepEqual(errs, s.expected) {
+					t.Errorf("returned the (%v) errors when expecting (%v)", errs, s.expected)
+				}
+			})
+		}
+	})
+}
+
+func Test_normalizeDocument(t *testing.T) {
+	document := config.Config{
+		"type":       "object",
+		"properties": map[interface{}]interface{}{"age": config.Config{"minimum": 1, "maximum": int64(2)}},
+		"required":   []interface{}{"age"},
+		"maxLength":  uint(3),
+		"multipleOf": float32(0.5),
+	}
+	expected := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"age": map[string]interface{}{"minimum": 1.0, "maximum": 2.0}},
+		"required":   []interface{}{"age"},
+		"maxLength":  3.0,
+		"multipleOf": 0.5,
+	}
+
+	if check := normalizeDocument(document); !reflect.DeepEqual(check, expected) {
+		t.Errorf("returned the (%v) document when expecting (%v)", check, expected)
+	}
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+// SchemaValidator is a function type used to define a calling interface
+// of function responsible to validate the raw request body against the
+// JSON Schema registered for the given endpoint id, returning an
+// initialized response envelope with all the founded schema violations
+type SchemaValidator func(ctx *gin.Context, id string) (*envelope.Envelope, error)
+
+// NewSchemaValidator instantiates a new request body JSON Schema
+// validation function.
+func NewSchemaValidator(
+	registry ISchemaRegistry,
+	parser IParser,
+) (SchemaValidator, error) {
+	// check registry argument reference
+	if registry == nil {
+		return nil, errNilPointer("registry")
+	}
+	// check parser argument reference
+	if parser == nil {
+		return nil, errNilPointer("parser")
+	}
+	// return the schema validation method instance
+	return func(ctx *gin.Context, id string) (*envelope.Envelope, error) {
+		// check the context argument reference
+		if ctx == nil || ctx.Request == nil {
+			return nil, errNilPointer("ctx")
+		}
+		// retrieve the endpoint schema
+		schema, e := registry.Get(id)
+		if e != nil {
+			return nil, e
+		}
+		// read the raw request body, restoring it to be later bound
+		var body []byte
+		if ctx.Request.Body != nil {
+			if body, e = io.ReadAll(ctx.Request.Body); e != nil {
+				return nil, e
+			}
+			ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		// validate the body against the schema
+		errs, e := schema.Validate(body)
+		if e != nil {
+			return parser.ParseBinding(BindBody, e, AcceptLanguages(ctx)...)
+		}
+		return parser.ParseSchema(errs, AcceptLanguages(ctx)...)
+	}, nil
+}
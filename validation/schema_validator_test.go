@@ -0,0 +1,176 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/golang/mock/gomock"
+	"github.com/happyhippyhippo/slate"
+	"github.com/happyhippyhippo/slate-rest/envelope"
+)
+
+func newTestSchemaValidator(
+	schema string,
+) SchemaValidator {
+	translator, _ := ut.New(en.New(), en.New()).GetTranslator("en")
+	parser, _ := NewParser(staticLocalizer(translator))
+	registry := NewSchemaRegistry()
+	if s, e := NewSchema([]byte(schema)); e == nil {
+		_ = registry.Add("endpoint", s)
+	}
+	v, _ := NewSchemaValidator(registry, parser)
+	return v
+}
+
+func Test_NewSchemaValidator(t *testing.T) {
+	t.Run("nil registry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if _, e := NewSchemaValidator(nil, NewMockParser(ctrl)); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("nil parser", func(t *testing.T) {
+		if _, e := NewSchemaValidator(NewSchemaRegistry(), nil); e == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("new schema validator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		if sut, e := NewSchemaValidator(NewSchemaRegistry(), NewMockParser(ctrl)); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if sut == nil {
+			t.Error("didn't returned a valid reference")
+		}
+	})
+}
+
+func Test_SchemaValidator_Call(t *testing.T) {
+	schema := `{"type":"object","required":["name"],"properties":{"name":{"type":"string"},"age":{"type":"integer","minimum":0}}}`
+
+	t.Run("nil context", func(t *testing.T) {
+		if _, e := newTestSchemaValidator(schema)(nil, "endpoint"); !errors.Is(e, slate.ErrNilPointer) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, slate.ErrNilPointer)
+		}
+	})
+
+	t.Run("schema not found", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{}`, nil)
+
+		if _, e := newTestSchemaValidator(schema)(ctx, "unknown"); !errors.Is(e, ErrSchemaNotFound) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, ErrSchemaNotFound)
+		}
+	})
+
+	t.Run("body read error", func(t *testing.T) {
+		expected := fmt.Errorf("error message")
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{}`, nil)
+		ctx.Request.Body = io.NopCloser(iotest.ErrReader(expected))
+
+		if _, e := newTestSchemaValidator(schema)(ctx, "endpoint"); !errors.Is(e, expected) {
+			t.Errorf("returned the (%v) error when expecting (%v)", e, expected)
+		}
+	})
+
+	t.Run("invalid JSON body", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{`, nil)
+
+		env, e := newTestSchemaValidator(schema)(ctx, "endpoint")
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case env == nil || len(env.Status.Errors) != 1:
+			t.Error("didn't returned the expected envelope")
+		case env.Status.Errors[0].GetCode() != "c:119":
+			t.Errorf("returned the (%v) error code", env.Status.Errors[0].GetCode())
+		}
+	})
+
+	t.Run("report the schema violations", func(t *testing.T) {
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `{"age":-1}`, nil)
+		expected := []struct {
+			code    string
+			field   string
+			message string
+		}{
+			{code: "c:104", field: "/name", message: "/name is required"},
+			{code: "c:90", field: "/age", message: "/age must be greater than or equal to 0"},
+		}
+
+		env, e := newTestSchemaValidator(schema)(ctx, "endpoint")
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case env == nil || len(env.Status.Errors) != len(expected):
+			t.Error("didn't returned the expected envelope")
+		case env.GetStatusCode() != http.StatusBadRequest:
+			t.Errorf("returned the (%v) status code", env.GetStatusCode())
+		default:
+			for i, x := range expected {
+				check := env.Status.Errors[i]
+				switch {
+				case check.GetCode() != x.code:
+					t.Errorf("returned the (%v) code when expecting (%v)", check.GetCode(), x.code)
+				case check.Field != x.field:
+					t.Errorf("returned the (%v) field when expecting (%v)", check.Field, x.field)
+				case check.GetMessage() != x.message:
+					t.Errorf("returned the (%v) message when expecting (%v)", check.GetMessage(), x.message)
+				}
+			}
+		}
+	})
+
+	t.Run("restore the validated body", func(t *testing.T) {
+		body := `{"name":"john","age":30}`
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, body, nil)
+
+		env, e := newTestSchemaValidator(schema)(ctx, "endpoint")
+		switch {
+		case e != nil:
+			t.Errorf("returned the unexpected error (%v)", e)
+		case env != nil:
+			t.Errorf("returned the unexpected (%v) envelope", env.Status.Errors)
+		default:
+			if check, _ := io.ReadAll(ctx.Request.Body); string(check) != body {
+				t.Errorf("restored the (%v) body", string(check))
+			}
+		}
+	})
+
+	t.Run("parse the errors with the request locale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := newTestBinderContext(http.MethodPost, "/", gin.MIMEJSON, `[]`, nil)
+		ctx.Request.Header.Set("Accept-Language", "pt-PT")
+		expected := envelope.NewEnvelope(http.StatusBadRequest, nil)
+		parser := NewMockParser(ctrl)
+		parser.EXPECT().ParseSchema([]SchemaError{{Tag: "type", Limit: "object"}}, "pt-PT").Return(expected, nil).Times(1)
+		registry := NewSchemaRegistry()
+		s, _ := NewSchema([]byte(`{"type":"object"}`))
+		_ = registry.Add("endpoint", s)
+		sut, _ := NewSchemaValidator(registry, parser)
+
+		if env, e := sut(ctx, "endpoint"); e != nil {
+			t.Errorf("returned the unexpected (%v) error", e)
+		} else if env != expected {
+			t.Errorf("returned the (%v) envelope", env)
+		}
+	})
+}
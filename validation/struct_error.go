@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// StructError defines the information of a struct-level or cross-field
+// validation error reported by a structure validation function. The
+// fields list holds the names of the structure fields involved in the
+// error, the params list holds the error parameter codes, the code
+// overrides the mapped error code of the error tag, and the message
+// (or translation key) overrides the translated error tag message.
+type StructError struct {
+	Fields  []string
+	Params  []int
+	Code    int
+	Message string
+}
+
+// ReportStructError will report a struct-level validation error, with
+// the given tag, to the structure validation level. The error
+// information will be used by the parser when converting the error
+// into an envelope status error.
+func ReportStructError(
+	sl validator.StructLevel,
+	tag string,
+	e StructError,
+) {
+	// check the struct level argument reference
+	if sl == nil {
+		return
+	}
+	// the error is reported over the first involved field
+	name := ""
+	if len(e.Fields) != 0 {
+		name = e.Fields[0]
+	}
+	param := ""
+	if len(e.Params) != 0 {
+		param = strconv.Itoa(e.Params[0])
+	}
+	sl.ReportError(e, name, name, tag, param)
+}
+
+// crossFieldTags defines the validation tags that compare the field
+// value with another field of the same structure.
+var crossFieldTags = map[string]bool{
+	"eqfield":       true,
+	"fieldcontains": true,
+	"fieldexcludes": true,
+	"gtefield":      true,
+	"gtfield":       true,
+	"ltefield":      true,
+	"ltfield":       true,
+	"nefield":       true,
+}
+
+// crossStructTags defines the validation tags that compare the field
+// value with another field referenced from the validated top structure.
+var crossStructTags = map[string]bool{
+	"eqcsfield":  true,
+	"gtcsfield":  true,
+	"gtecsfield": true,
+	"ltcsfield":  true,
+	"ltecsfield": true,
+	"necsfield":  true,
+}
+
+func structError(
+	value interface{},
+) (*StructError, bool) {
+	switch e := value.(type) {
+	case StructError:
+		return &e, true
+	case *StructError:
+		return e, e != nil
+	}
+	return nil, false
+}
@@ -0,0 +1,313 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+type structErrorTestPeriod struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type structErrorTestRequest struct {
+	Name   string                `json:"name" validate:"required"`
+	Period structErrorTestPeriod `json:"period"`
+}
+
+func Test_ReportStructError(t *testing.T) {
+	t.Run("nil struct level", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Errorf("unexpected panic (%v)", r)
+			}
+		}()
+
+		ReportStructError(nil, "period", StructError{})
+	})
+
+	t.Run("report the struct error", func(t *testing.T) {
+		expected := StructError{Fields: []string{"End", "Start"}, Params: []int{3, 4}, Code: 2000, Message: "period"}
+		validate := validator.New()
+		validate.RegisterStructValidation(func(sl validator.StructLevel) {
+			ReportStructError(sl, "period", expected)
+		}, structErrorTestPeriod{})
+
+		errs := validate.Struct(structErrorTestRequest{Name: "name"}).(validator.ValidationErrors)
+		switch {
+		case len(errs) != 1:
+			t.Errorf("returned the (%v) errors", errs)
+		case errs[0].Tag() != "period":
+			t.Errorf("returned the (%v) tag", errs[0].Tag())
+		case errs[0].StructNamespace() != "structErrorTestRequest.Period.End":
+			t.Errorf("returned the (%v) namespace", errs[0].StructNamespace())
+		case errs[0].Param() != "3":
+			t.Errorf("returned the (%v) param", errs[0].Param())
+		case !reflect.DeepEqual(errs[0].Value(), expected):
+			t.Errorf("returned the (%v) value", errs[0].Value())
+		}
+	})
+
+	t.Run("report the struct error without fields and params", func(t *testing.T) {
+		validate := validator.New()
+		validate.RegisterStructValidation(func(sl validator.StructLevel) {
+			ReportStructError(sl, "period", StructError{})
+		}, structErrorTestPeriod{})
+
+		errs := validate.Struct(structErrorTestRequest{Name: "name"}).(validator.ValidationErrors)
+		switch {
+		case len(errs) != 1:
+			t.Errorf("returned the (%v) errors", errs)
+		case errs[0].StructNamespace() != "structErrorTestRequest.Period.":
+			t.Errorf("returned the (%v) namespace", errs[0].StructNamespace())
+		case errs[0].Param() != "":
+			t.Errorf("returned the (%v) param", errs[0].Param())
+		}
+	})
+}
+
+func Test_structError(t *testing.T) {
+	scenarios := []struct {
+		test  string
+		value interface{}
+		found bool
+	}{
+		{ // nil value
+			test:  "nil value",
+			value: nil,
+			found: false,
+		},
+		{ // non struct error value
+			test:  "non struct error value",
+			value: "string",
+			found: false,
+		},
+		{ // nil struct error pointer
+			test:  "nil struct error pointer",
+			value: (*StructError)(nil),
+			found: false,
+		},
+		{ // struct error value
+			test:  "struct error value",
+			value: StructError{Code: 1},
+			found: true,
+		},
+		{ // struct error pointer
+			test:  "struct error pointer",
+			value: &StructError{Code: 1},
+			found: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			e, found := structError(s.value)
+			switch {
+			case found != s.found:
+				t.Errorf("returned the (%v) found flag", found)
+			case found && e.Code != 1:
+				t.Errorf("returned the unexpected (%v) struct error", e)
+			}
+		})
+	}
+}
+
+func Test_Parser_StructErrors(t *testing.T) {
+	universal := ut.New(en.New(), en.New())
+	translator, _ := universal.GetTranslator("en")
+	_ = translator.Add("period_order", "{0} must be after {1}", true)
+
+	scenarios := []struct {
+		test    string
+		report  StructError
+		code    string
+		message string
+		field   string
+		meta    map[string]interface{}
+	}{
+		{ // struct error with the tag mapped code and message
+			test:    "struct error with the tag mapped code and message",
+			report:  StructError{},
+			code:    "c:999",
+			message: "failed the period validation",
+			field:   "period",
+		},
+		{ // struct error with custom code, message and parameters
+			test:    "struct error with custom code, message and parameters",
+			report:  StructError{Fields: []string{"End", "Start"}, Params: []int{3, 4}, Code: 2000, Message: "period_order"},
+			code:    "p:3.c:2000",
+			message: "End must be after Start",
+			field:   "period.end",
+			meta: map[string]interface{}{
+				"params": []int{3, 4},
+				"fields": []string{"period.end", "period.start"},
+			},
+		},
+		{ // struct error with an untranslated message and an unknown field
+			test:    "struct error with an untranslated message and an unknown field",
+			report:  StructError{Fields: []string{"Range"}, Params: []int{5}, Message: "invalid period"},
+			code:    "p:5.c:999",
+			message: "invalid period",
+			field:   "period.Range",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			report := s.report
+			validate := validator.New()
+			validate.RegisterStructValidation(func(sl validator.StructLevel) {
+				ReportStructError(sl, "period", report)
+			}, structErrorTestPeriod{})
+			data := structErrorTestRequest{}
+
+			sut, _ := NewParser(staticLocalizer(translator))
+			sut.SetDefaultCode(999)
+
+			resp, e := sut.Parse(data, validate.Struct(data).(validator.ValidationErrors))
+			switch {
+			case e != nil:
+				t.Errorf("return the unexpected error (%v)", e)
+			case len(resp.Status.Errors) != 2:
+				t.Errorf("didn't aggregated the field and struct errors (%v)", resp.Status.Errors)
+			case resp.Status.Errors[0].GetCode() != "c:104" || resp.Status.Errors[0].Field != "name":
+				t.Errorf("returned the unexpected (%v) field error", resp.Status.Errors[0])
+			default:
+				check := resp.Status.Errors[1]
+				switch {
+				case check.GetCode() != s.code:
+					t.Errorf("returned the (%v) code when expecting (%v)", check.GetCode(), s.code)
+				case check.GetMessage() != s.message:
+					t.Errorf("returned the (%v) message when expecting (%v)", check.GetMessage(), s.message)
+				case check.Field != s.field:
+					t.Errorf("returned the (%v) field when expecting (%v)", check.Field, s.field)
+				case !reflect.DeepEqual(check.Meta, s.meta):
+					t.Errorf("returned the (%v) meta when expecting (%v)", check.Meta, s.meta)
+				}
+			}
+		})
+	}
+}
+
+func Test_Parser_ReportedErrors(t *testing.T) {
+	universal := ut.New(en.New(), en.New())
+	translator, _ := universal.GetTranslator("en")
+	_ = translator.Add("period_order", "{0} must be after {1}", true)
+
+	scenarios := []struct {
+		test    string
+		report  func(sl validator.StructLevel)
+		code    string
+		message string
+		field   string
+	}{
+		{ // reported field error with the tag default message
+			test: "reported field error with the tag default message",
+			report: func(sl validator.StructLevel) {
+				sl.ReportError(0, "end", "End", "required", "")
+			},
+			code:    "c:104",
+			message: "period.end is required",
+			field:   "period.end",
+		},
+		{ // reported unknown field error with the tag translated message
+			test: "reported unknown field error with the tag translated message",
+			report: func(sl validator.StructLevel) {
+				sl.ReportError(0, "range", "Range", "period_order", "start")
+			},
+			code:    "c:999",
+			message: "period.Range must be after start",
+			field:   "period.Range",
+		},
+		{ // reported field error without a tag message
+			test: "reported field error without a tag message",
+			report: func(sl validator.StructLevel) {
+				sl.ReportError(0, "start", "Start", "period", "")
+			},
+			code:    "c:999",
+			message: "failed the period validation",
+			field:   "period.start",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.test, func(t *testing.T) {
+			validate := validator.New()
+			validate.RegisterStructValidation(s.report, structErrorTestPeriod{})
+			data := structErrorTestRequest{Name: "name"}
+
+			sut, _ := NewParser(staticLocalizer(translator))
+			sut.SetDefaultCode(999)
+
+			resp, e := sut.Parse(data, validate.Struct(data).(validator.ValidationErrors))
+			switch {
+			case e != nil:
+				t.Errorf("return the unexpected error (%v)", e)
+			case len(resp.Status.Errors) != 1:
+				t.Errorf("returned the unexpected (%v) errors", resp.Status.Errors)
+			default:
+				check := resp.Status.Errors[0]
+				switch {
+				case check.GetCode() != s.code:
+					t.Errorf("returned the (%v) code when expecting (%v)", check.GetCode(), s.code)
+				case check.GetMessage() != s.message:
+					t.Errorf("returned the (%v) message when expecting (%v)", check.GetMessage(), s.message)
+				case check.Field != s.field:
+					t.Errorf("returned the (%v) field when expecting (%v)", check.Field, s.field)
+				}
+			}
+		})
+	}
+}
+
+func Test_Parser_CrossFieldErrors(t *testing.T) {
+	type inner struct {
+		Min int `json:"min"`
+		Max int `json:"max" validate:"gtfield=Min"`
+	}
+	type request struct {
+		Limit    int    `json:"limit"`
+		Password string `json:"password"`
+		Confirm  string `json:"confirm" validate:"eqfield=Password"`
+		Range    inner  `json:"range"`
+		Top      int    `json:"top" validate:"ltecsfield=Range.Min"`
+	}
+	data := request{Password: "a", Confirm: "b", Range: inner{Min: 2, Max: 1}, Top: 3}
+	expected := []struct {
+		code   string
+		field  string
+		fields []string
+	}{
+		{code: "c:2", field: "confirm", fields: []string{"confirm", "password"}},
+		{code: "c:8", field: "range.max", fields: []string{"range.max", "range.min"}},
+		{code: "c:10", field: "top", fields: []string{"top", "range.min"}},
+	}
+	translator, _ := ut.New(en.New(), en.New()).GetTranslator("en")
+	validate := validator.New()
+
+	sut, _ := NewParser(staticLocalizer(translator))
+
+	resp, e := sut.Parse(&data, validate.Struct(&data).(validator.ValidationErrors))
+	switch {
+	case e != nil:
+		t.Errorf("return the unexpected error (%v)", e)
+	case len(resp.Status.Errors) != len(expected):
+		t.Errorf("returned the (%v) errors", resp.Status.Errors)
+	default:
+		for i, x := range expected {
+			check := resp.Status.Errors[i]
+			switch {
+			case check.GetCode() != x.code:
+				t.Errorf("returned the (%v) code when expecting (%v)", check.GetCode(), x.code)
+			case check.Field != x.field:
+				t.Errorf("returned the (%v) field when expecting (%v)", check.Field, x.field)
+			case !reflect.DeepEqual(check.Meta["fields"], x.fields):
+				t.Errorf("returned the (%v) fields when expecting (%v)", check.Meta["fields"], x.fields)
+			}
+		}
+	}
+}
@@ -7,8 +7,9 @@ import (
 
 // Validator is a function type used to define a calling interface of
 // function responsible to validate an instance of a structure and return
-// an initialized response envelope with the founded error
-type Validator func(val interface{}) (*envelope.Envelope, error)
+// an initialized response envelope with the founded error translated
+// to the first supported locale of the given list
+type Validator func(val interface{}, locale ...string) (*envelope.Envelope, error)
 
 // NewValidator instantiates a new validation function
 func NewValidator(
@@ -24,7 +25,7 @@ func NewValidator(
 		return nil, errNilPointer("parser")
 	}
 	// return the validation method instance
-	return func(value interface{}) (*envelope.Envelope, error) {
+	return func(value interface{}, locale ...string) (*envelope.Envelope, error) {
 		// check the value argument reference
 		if value == nil {
 			return nil, errNilPointer("value")
@@ -32,7 +33,7 @@ func NewValidator(
 		// validate the given structure
 		if errs := validate.Struct(value); errs != nil {
 			// compose the response envelope with the parsed validation error
-			return parser.Parse(value, errs.(validator.ValidationErrors))
+			return parser.Parse(value, errs.(validator.ValidationErrors), locale...)
 		}
 		return nil, nil
 	}, nil
@@ -134,4 +134,24 @@ func Test_Validator_Call(t *testing.T) {
 			t.Errorf("returned the (%v) envelope instead of the expected (%v)", resp, expected)
 		}
 	})
+
+	t.Run("forward the requested locales to the parser", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		data := struct {
+			Field1 int `validate:"gt=0,lte=10" vparam:"1"`
+		}{Field1: 11}
+		expected := envelope.NewEnvelope(http.StatusBadRequest, nil, nil)
+		parser := NewMockParser(ctrl)
+		parser.EXPECT().Parse(data, gomock.Any(), "pt", "en").Return(expected, nil).Times(1)
+
+		instance, _ := NewValidator(validator.New(), parser)
+
+		if resp, e := instance(data, "pt", "en"); e != nil {
+			t.Errorf("returned the unexpected error (%v)", e)
+		} else if !reflect.DeepEqual(resp, expected) {
+			t.Errorf("returned the (%v) envelope instead of the expected (%v)", resp, expected)
+		}
+	})
 }